@@ -0,0 +1,123 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// compileComposite compiles a negated rule, such as "!lowercase", or an alternation, such as "email|e164",
+// into a single rule. Unlike plain rules, the rules of a composite must be registered,
+// as an unknown alternative would make the result meaningless.
+func compileComposite(item tagItem, rules map[string]FieldRule, patterns map[string]*regexp.Regexp) (rulePlan, error) {
+	rule := rulePlan{
+		name:  item.ruleName(),
+		param: item.param,
+		args:  item.args,
+		tag:   item.raw(),
+		group: item.group,
+	}
+
+	if item.alternatives == nil {
+		negated, err := resolveRule(item, rules, patterns)
+		if err != nil {
+			return rule, err
+		}
+		rule.validateFunc = negateRule(negated)
+		return rule, nil
+	}
+
+	alternatives := make([]rulePlan, len(item.alternatives))
+	for i, alternative := range item.alternatives {
+		resolved, err := resolveRule(alternative, rules, patterns)
+		if err != nil {
+			return rule, err
+		}
+		if alternative.negate {
+			resolved.validateFunc = negateRule(resolved)
+			resolved.name = alternative.ruleName()
+		}
+		alternatives[i] = resolved
+	}
+	rule.tag = rule.name
+	rule.validateFunc = anyRule(alternatives)
+	return rule, nil
+}
+
+// resolveRule resolves the validation function of a rule of a composite.
+// It returns an error if the rule is not registered or its regular expression is invalid.
+func resolveRule(item tagItem, rules map[string]FieldRule, patterns map[string]*regexp.Regexp) (rulePlan, error) {
+	validateFunc, ok, err := lookupRule(item, rules, patterns)
+	if err != nil {
+		return rulePlan{}, err
+	}
+	if !ok {
+		return rulePlan{}, fmt.Errorf("unknown rule %s", item.name)
+	}
+	return rulePlan{
+		name:         item.name,
+		param:        item.param,
+		args:         item.args,
+		tag:          item.raw(),
+		validateFunc: validateFunc,
+	}, nil
+}
+
+// negateRule returns a validation function that passes if the given rule fails, and fails if it passes,
+// e.g. "!lowercase" to reject lowercase-only values.
+// Only validation failures, reported as RuleError or by a custom rule, are negated; other errors, such as an invalid rule parameter
+// or an unsupported type, are returned as they are, so that "!min=abc" doesn't pass every value.
+func negateRule(rule rulePlan) FieldRule {
+	return func(rc *RuleContext) error {
+		rc.Rule, rc.Param, rc.Args, rc.Tag = rule.name, rule.param, rule.args, rule.tag
+		if err := rule.validateFunc(rc); err != nil {
+			if !isFailure(err) {
+				return err
+			}
+			return nil
+		}
+		return NewRuleError(rc.Messages, "not", rc.Field, locales.Param{Name: "rule", Value: rule.tag})
+	}
+}
+
+// anyRule returns a validation function that passes if any of the given rules passes.
+// If all of them fail, the error lists the messages of the alternatives joined by the localized "or".
+// Errors other than validation failures, such as an invalid rule parameter, are returned as they are
+// once no alternative passes.
+func anyRule(alternatives []rulePlan) FieldRule {
+	return func(rc *RuleContext) error {
+		messages := make([]string, 0, len(alternatives))
+		var ruleErr error
+		for _, rule := range alternatives {
+			rc.Rule, rc.Param, rc.Args, rc.Tag = rule.name, rule.param, rule.args, rule.tag
+			err := rule.validateFunc(rc)
+			if err == nil {
+				return nil
+			}
+			if !isFailure(err) {
+				if ruleErr == nil {
+					ruleErr = err
+				}
+				continue
+			}
+			messages = append(messages, err.Error())
+		}
+		if ruleErr != nil {
+			return ruleErr
+		}
+
+		separator := " " + rc.Messages.Format("or", rc.Field) + " "
+		return NewRuleError(rc.Messages, "anyOf", rc.Field, locales.Param{Name: "errors", Value: strings.Join(messages, separator)})
+	}
+}
+
+// isFailure reports whether an error is a validation failure, reported as a RuleError with a localized message
+// or returned by a custom rule.
+func isFailure(err error) bool {
+	var ruleErr *RuleError
+	var failure *ruleFailure
+	return errors.As(err, &ruleErr) || errors.As(err, &failure)
+}
@@ -0,0 +1,175 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// TestCompositeRules tests alternations and negated rules in tags.
+func TestCompositeRules(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Contact struct {
+		Handle   string `validate:"required,email|e164"`
+		Password string `validate:"!lowercase|min=12"`
+		Nickname string `validate:"omitempty,!email"`
+	}
+
+	testCases := []struct {
+		name     string  // Name of the test case
+		input    Contact // Input struct to be validated
+		expected string  // Expected error message, empty if validation passes
+	}{
+		{
+			name:  "Email",
+			input: Contact{Handle: "john@example.com", Password: "lowercaseonly1234"},
+		},
+		{
+			name:  "Phone",
+			input: Contact{Handle: "+905551234567", Password: "SHORT", Nickname: "johnny"},
+		},
+		{
+			name:     "AllAlternativesFail",
+			input:    Contact{Handle: "john", Password: "SHORT"},
+			expected: "Handle must satisfy one of the following: Handle is not a valid email address or Handle must be a valid E.164 phone number",
+		},
+		{
+			name:     "NegatedRuleFails",
+			input:    Contact{Handle: "john@example.com", Password: "MIXED", Nickname: "john@example.com"},
+			expected: "Nickname must not satisfy the email rule",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateStruct(tc.input, "en")
+			if tc.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestCompositeRuleErrors tests the rule names and messages recorded for composite rules.
+func TestCompositeRuleErrors(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	err := ValidateStruct(struct {
+		Code string `validate:"!uppercase|min=3"`
+	}{Code: "Ab"}, "tr")
+
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 1 {
+		t.Fatalf("Expected one validation error, got '%v'", err)
+	}
+	fieldErr := validationErrors[0]
+	if fieldErr.Rule != "!uppercase|min" {
+		t.Errorf("Expected rule '!uppercase|min', got '%s'", fieldErr.Rule)
+	}
+	expected := "Code şunlardan birini sağlamalıdır: Code, uppercase kuralını sağlamamalıdır veya Code en az 3 karakter olmalıdır"
+	if fieldErr.Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, fieldErr.Message)
+	}
+}
+
+// TestCompositeConfigurationErrors tests that errors other than validation failures are not negated or swallowed.
+func TestCompositeConfigurationErrors(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		expected string      // Expected error message
+	}{
+		{name: "NegatedInvalidParameter", input: "abc", tag: "!min=abc", expected: `strconv.Atoi: parsing "abc": invalid syntax`},
+		{name: "NegatedUnsupportedType", input: true, tag: "!gt=1", expected: "unsupported type for gt: bool"},
+		{name: "AlternativeInvalidParameter", input: "abc", tag: "email|min=abc", expected: `strconv.Atoi: parsing "abc": invalid syntax`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.ValidateVar("", tc.input, tc.tag, Options{})
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestCompositeCustomRules tests that the plain errors returned by custom rules are failures in composite rules.
+func TestCompositeCustomRules(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterValidationRule("custom", func(value reflect.Value, _ locales.ErrorMessages, field, _ string) error {
+		if value.Kind() != reflect.String || !strings.HasPrefix(value.String(), "#") {
+			return fmt.Errorf("%s is not custom", field)
+		}
+		return nil
+	})
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		expected string      // Expected error message, empty if validation passes
+	}{
+		{name: "Custom", input: "#tag", tag: "custom"},
+		{name: "NotCustom", input: "tag", tag: "custom", expected: "Value is not custom"},
+		{name: "AlternativeFirst", input: "a@b.com", tag: "custom|email"},
+		{name: "AlternativeLast", input: "a@b.com", tag: "email|custom"},
+		{name: "NoAlternative", input: "tag", tag: "custom|email", expected: "Value must satisfy one of the following: Value is not custom or Value is not a valid email address"},
+		{name: "Negated", input: "tag", tag: "!custom"},
+		{name: "NegatedFailure", input: "#tag", tag: "!custom", expected: "Value must not satisfy the custom rule"},
+		{name: "AlternativeAfterInvalidParameter", input: "#tag", tag: "min=abc|custom"},
+		{name: "AlternativeInvalidParameter", input: "tag", tag: "min=abc|custom", expected: `strconv.Atoi: parsing "abc": invalid syntax`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := registry.ValidateVar("", tc.input, tc.tag, Options{})
+			if tc.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestCompositeTagErrors tests that composites of unknown rules and misused keywords are reported.
+func TestCompositeTagErrors(t *testing.T) {
+	testCases := map[string]string{
+		"email|unknown": "unknown rule unknown",
+		"!unknown":      "unknown rule unknown",
+		"email|":        "empty alternative",
+		"!omitempty":    "omitempty takes no parameter, group or negation",
+		"!":             `missing rule name in ""`,
+	}
+
+	for tag, expected := range testCases {
+		t.Run(tag, func(t *testing.T) {
+			items, err := parseTag(tag)
+			if err == nil {
+				_, err = compileChain(items, defaultValidationRules(), nil)
+			}
+			if err == nil || err.Error() != expected {
+				t.Errorf("Expected error '%s', got '%v'", expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// validateRequiredIf validates if a value is not empty when all the given fields have the given values,
+// e.g. "required_if=AccountType business" or "required_if=Country TR Type company".
+func validateRequiredIf(rc *RuleContext) error {
+	matches, err := fieldsMatch(rc)
+	if err != nil {
+		return err
+	}
+	return requireIf(rc, matches)
+}
+
+// validateRequiredUnless validates if a value is not empty unless all the given fields have the given values,
+// e.g. "required_unless=AccountType personal".
+func validateRequiredUnless(rc *RuleContext) error {
+	matches, err := fieldsMatch(rc)
+	if err != nil {
+		return err
+	}
+	return requireIf(rc, !matches)
+}
+
+// validateRequiredWith validates if a value is not empty when any of the given fields is not empty,
+// e.g. "required_with=Phone Email".
+func validateRequiredWith(rc *RuleContext) error {
+	present, err := countPresentFields(rc)
+	if err != nil {
+		return err
+	}
+	return requireIf(rc, present > 0)
+}
+
+// validateRequiredWithAll validates if a value is not empty when all the given fields are not empty.
+func validateRequiredWithAll(rc *RuleContext) error {
+	present, err := countPresentFields(rc)
+	if err != nil {
+		return err
+	}
+	return requireIf(rc, present == len(ruleArgs(rc)))
+}
+
+// validateRequiredWithout validates if a value is not empty when any of the given fields is empty,
+// e.g. "required_without=Email" to require a phone number when no email address is given.
+func validateRequiredWithout(rc *RuleContext) error {
+	present, err := countPresentFields(rc)
+	if err != nil {
+		return err
+	}
+	return requireIf(rc, present < len(ruleArgs(rc)))
+}
+
+// validateExcludedIf validates if a value is empty when all the given fields have the given values,
+// e.g. "excluded_if=AccountType personal".
+func validateExcludedIf(rc *RuleContext) error {
+	matches, err := fieldsMatch(rc)
+	if err != nil {
+		return err
+	}
+	return excludeIf(rc, matches)
+}
+
+// validateExcludedWith validates if a value is empty when any of the given fields is not empty.
+func validateExcludedWith(rc *RuleContext) error {
+	present, err := countPresentFields(rc)
+	if err != nil {
+		return err
+	}
+	return excludeIf(rc, present > 0)
+}
+
+// requireIf returns the required error message if the condition holds and the value is empty.
+func requireIf(rc *RuleContext, condition bool) error {
+	if condition && isEmpty(rc.Value) {
+		return NewRuleError(rc.Messages, "required", rc.Field)
+	}
+	return nil
+}
+
+// excludeIf returns the excluded error message if the condition holds and the value is not empty.
+func excludeIf(rc *RuleContext, condition bool) error {
+	if condition && !isEmpty(rc.Value) {
+		return NewRuleError(rc.Messages, "excluded", rc.Field)
+	}
+	return nil
+}
+
+// fieldsMatch reports whether all the fields in the rule parameter have the given values.
+// The parameter is a space-separated list of field and value pairs, e.g. "AccountType business".
+// Values are compared with the string representation of the field values.
+func fieldsMatch(rc *RuleContext) (bool, error) {
+	params := ruleArgs(rc)
+	if len(params) == 0 || len(params)%2 != 0 {
+		return false, fmt.Errorf("invalid parameter for %s: %s", rc.Rule, rc.Param)
+	}
+
+	for i := 0; i < len(params); i += 2 {
+		field, ok := rc.FieldByPath(params[i])
+		if !ok {
+			return false, fmt.Errorf("unknown field for %s: %s", rc.Rule, params[i])
+		}
+		if fieldString(field) != params[i+1] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// countPresentFields returns the number of fields in the rule parameter that are not empty.
+// The parameter is a space-separated list of field names, e.g. "Phone Email".
+// Unexported fields cannot be checked and are reported as an error.
+func countPresentFields(rc *RuleContext) (int, error) {
+	names := ruleArgs(rc)
+	if len(names) == 0 {
+		return 0, fmt.Errorf("invalid parameter for %s: %s", rc.Rule, rc.Param)
+	}
+
+	present := 0
+	for _, name := range names {
+		field, ok := rc.FieldByPath(name)
+		if !ok {
+			return 0, fmt.Errorf("unknown field for %s: %s", rc.Rule, name)
+		}
+		if !field.CanInterface() {
+			return 0, fmt.Errorf("unexported field for %s: %s", rc.Rule, name)
+		}
+		if !isEmpty(field) {
+			present++
+		}
+	}
+	return present, nil
+}
+
+// fieldString returns the string representation of a field value, dereferencing pointers.
+// Nil pointers and unexported fields are represented by an empty string.
+func fieldString(field reflect.Value) string {
+	field = indirect(field)
+	if !field.IsValid() || !field.CanInterface() {
+		return ""
+	}
+	if field.Kind() == reflect.String {
+		return field.String()
+	}
+	return fmt.Sprint(field.Interface())
+}
+
+// ruleArgs returns the arguments of the rule parameter, splitting the parameter on spaces
+// if the rule context holds no parsed arguments.
+func ruleArgs(rc *RuleContext) []string {
+	if rc.Args != nil {
+		return rc.Args
+	}
+	return strings.Fields(rc.Param)
+}
@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type Account struct {
+	AccountType string
+	Country     string
+	CompanyName string `validate:"required_if=AccountType business"`
+	TaxNumber   string `validate:"required_if=AccountType business Country TR"`
+	FirstName   string `validate:"required_unless=AccountType business"`
+	Phone       string `validate:"required_without=Email"`
+	Email       string
+	Street      string
+	City        string `validate:"required_with=Street"`
+	ZipCode     string `validate:"required_with_all=Street City"`
+	Nickname    string `validate:"excluded_if=AccountType business"`
+	Discount    *int   `validate:"excluded_with=CompanyName"`
+}
+
+// TestConditionalRules tests requiring or excluding fields depending on sibling field values.
+func TestConditionalRules(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	discount := 10
+
+	// Define test cases
+	testCases := []struct {
+		name   string   // Name of the test case
+		input  Account  // Input struct to be validated
+		expect []string // Expected "field:rule" of the failing fields
+	}{
+		{
+			name:   "ValidPersonalAccount",
+			input:  Account{AccountType: "personal", FirstName: "John", Email: "john@example.com", Nickname: "johnny", Discount: &discount},
+			expect: nil,
+		},
+		{
+			name:   "ValidBusinessAccount",
+			input:  Account{AccountType: "business", Country: "TR", CompanyName: "ACME", TaxNumber: "123", Phone: "555"},
+			expect: nil,
+		},
+		{
+			name:   "InvalidPersonalAccount",
+			input:  Account{AccountType: "personal", Street: "Main St", ZipCode: "34000"},
+			expect: []string{"FirstName:required_unless", "Phone:required_without", "City:required_with"},
+		},
+		{
+			name:   "InvalidBusinessAccount",
+			input:  Account{AccountType: "business", Country: "TR", Email: "info@acme.com", Street: "Main St", City: "Istanbul", Nickname: "acme", Discount: &discount},
+			expect: []string{"CompanyName:required_if", "TaxNumber:required_if", "ZipCode:required_with_all", "Nickname:excluded_if"},
+		},
+		{
+			name:   "ExcludedWithPresentField",
+			input:  Account{AccountType: "business", CompanyName: "ACME", Email: "info@acme.com", Discount: &discount},
+			expect: []string{"Discount:excluded_with"},
+		},
+	}
+
+	// Run test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateStruct(tc.input, "en")
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Field+":"+fieldErr.Rule)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing fields %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestConditionalMessages tests the localized messages of conditional rules.
+func TestConditionalMessages(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Form struct {
+		Type    string
+		Company string `validate:"required_if=Type business"`
+		Alias   string `validate:"excluded_if=Type business"`
+	}
+
+	err := ValidateStruct(Form{Type: "business", Alias: "acme"}, "en")
+	expected := "Company is required;\nAlias must be empty"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestConditionalInvalidParameters tests that invalid rule parameters are reported.
+func TestConditionalInvalidParameters(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name   string      // Name of the test case
+		input  interface{} // Input struct with an invalid tag
+		expect string      // Expected error message
+	}{
+		{
+			name: "MissingValue",
+			input: struct {
+				Value string `validate:"required_if=Type"`
+			}{},
+			expect: "invalid parameter for required_if: Type",
+		},
+		{
+			name: "UnknownField",
+			input: struct {
+				Value string `validate:"required_with=Missing"`
+			}{},
+			expect: "unknown field for required_with: Missing",
+		},
+		{
+			name: "UnexportedField",
+			input: struct {
+				Value  string `validate:"required_with=secret"`
+				secret int
+			}{secret: 1},
+			expect: "unexported field for required_with: secret",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := ValidateStruct(tc.input, "en"); err == nil || err.Error() != tc.expect {
+				t.Errorf("Expected error '%s', got '%v'", tc.expect, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// dateLayout is the layout of the dates accepted by the date rule.
+const dateLayout = "2006-01-02"
+
+// validateEqField validates if a value is equal to the value of another field.
+// The rule parameter is the name of the other field, e.g. "eqfield=Password".
+func validateEqField(rc *RuleContext) error {
+	return compareField(rc, "eqField", func(c int) bool { return c == 0 })
+}
+
+// validateNeField validates if a value is not equal to the value of another field.
+func validateNeField(rc *RuleContext) error {
+	return compareField(rc, "neField", func(c int) bool { return c != 0 })
+}
+
+// validateGtField validates if a value is greater than the value of another field.
+// For dates in the "YYYY-MM-DD" format or time.Time values, greater means later.
+func validateGtField(rc *RuleContext) error {
+	return compareField(rc, "gtField", func(c int) bool { return c > 0 })
+}
+
+// validateGteField validates if a value is greater than or equal to the value of another field.
+func validateGteField(rc *RuleContext) error {
+	return compareField(rc, "gteField", func(c int) bool { return c >= 0 })
+}
+
+// validateLtField validates if a value is less than the value of another field.
+// For dates in the "YYYY-MM-DD" format or time.Time values, less means earlier.
+func validateLtField(rc *RuleContext) error {
+	return compareField(rc, "ltField", func(c int) bool { return c < 0 })
+}
+
+// validateLteField validates if a value is less than or equal to the value of another field.
+func validateLteField(rc *RuleContext) error {
+	return compareField(rc, "lteField", func(c int) bool { return c <= 0 })
+}
+
+// compareField compares the value of the rule context with the field referenced by the rule parameter,
+// and returns the error message with the given key if the comparison result doesn't satisfy the check.
+// It returns an error if the other field cannot be found or the values cannot be compared.
+func compareField(rc *RuleContext, key string, check func(c int) bool) error {
+	other, ok := rc.FieldByPath(rc.Param)
+	if !ok {
+		return fmt.Errorf("unknown field for %s: %s", rc.Rule, rc.Param)
+	}
+
+	// Equality checks accept any comparable values, ordering checks require ordered values
+	equality := key == "eqField" || key == "neField"
+	c, err := compareValues(rc.Value, other, equality)
+	if err != nil {
+		return err
+	}
+
+	if !check(c) {
+		return NewRuleError(rc.Messages, key, rc.Field, locales.Param{Name: "other", Value: rc.Param})
+	}
+	return nil
+}
+
+// compareValues compares two values and returns -1, 0 or +1 if the first value is less than, equal to or greater than the second.
+// Numbers of any kind are compared numerically, time.Time values and dates in the "YYYY-MM-DD" format chronologically,
+// and other strings lexicographically. If equality is true, other values of the same type are compared for equality,
+// returning 0 if they are equal and 1 otherwise.
+func compareValues(a, b reflect.Value, equality bool) (int, error) {
+	a, b = indirect(a), indirect(b)
+	if !a.IsValid() || !b.IsValid() {
+		// Nil pointers are only equal to each other
+		if equality {
+			if !a.IsValid() && !b.IsValid() {
+				return 0, nil
+			}
+			return 1, nil
+		}
+		return 0, fmt.Errorf("cannot compare nil values")
+	}
+	if !a.CanInterface() || !b.CanInterface() {
+		return 0, fmt.Errorf("cannot compare unexported fields")
+	}
+
+	// Compare times chronologically
+	if ta, ok := a.Interface().(time.Time); ok {
+		if tb, ok := b.Interface().(time.Time); ok {
+			return ta.Compare(tb), nil
+		}
+	}
+
+	switch {
+	case isNumber(a) && isNumber(b):
+		return compareNumbers(a, b), nil
+	case a.Kind() == reflect.String && b.Kind() == reflect.String:
+		// Compare dates chronologically, other strings lexicographically
+		da, errA := time.Parse(dateLayout, a.String())
+		db, errB := time.Parse(dateLayout, b.String())
+		if errA == nil && errB == nil {
+			return da.Compare(db), nil
+		}
+		return strings.Compare(a.String(), b.String()), nil
+	case equality && a.Type() == b.Type():
+		if reflect.DeepEqual(a.Interface(), b.Interface()) {
+			return 0, nil
+		}
+		return 1, nil
+	default:
+		return 0, fmt.Errorf("cannot compare %v with %v", a.Type(), b.Type())
+	}
+}
+
+// compareNumbers compares two numeric values of any integer, unsigned integer or floating-point kind.
+func compareNumbers(a, b reflect.Value) int {
+	switch {
+	case isInt(a) && isInt(b):
+		return compareOrdered(a.Int(), b.Int())
+	case isUint(a) && isUint(b):
+		return compareOrdered(a.Uint(), b.Uint())
+	case isInt(a) && isUint(b):
+		if a.Int() < 0 {
+			return -1
+		}
+		return compareOrdered(uint64(a.Int()), b.Uint())
+	case isUint(a) && isInt(b):
+		if b.Int() < 0 {
+			return 1
+		}
+		return compareOrdered(a.Uint(), uint64(b.Int()))
+	default:
+		return compareOrdered(toFloat(a), toFloat(b))
+	}
+}
+
+// compareOrdered compares two ordered values.
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// indirect dereferences pointers and interfaces, returning an invalid value for nil.
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// isInt reports whether a value is of a signed integer kind.
+func isInt(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// isUint reports whether a value is of an unsigned integer kind.
+func isUint(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// isNumber reports whether a value is of an integer, unsigned integer or floating-point kind.
+func isNumber(value reflect.Value) bool {
+	return isInt(value) || isUint(value) || value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64
+}
+
+// toFloat converts a numeric value to a float64.
+func toFloat(value reflect.Value) float64 {
+	switch {
+	case isInt(value):
+		return float64(value.Int())
+	case isUint(value):
+		return float64(value.Uint())
+	default:
+		return value.Float()
+	}
+}
@@ -0,0 +1,165 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type Signup struct {
+	Password        string `validate:"required"`
+	PasswordConfirm string `validate:"eqfield=Password"`
+	Username        string `validate:"nefield=Password"`
+}
+
+type Booking struct {
+	StartDate string    `validate:"date"`
+	EndDate   string    `validate:"date,gtfield=StartDate"`
+	CheckIn   time.Time `validate:"ltefield=CheckOut"`
+	CheckOut  time.Time
+	MinGuests int     `validate:"ltefield=MaxGuests"`
+	MaxGuests uint8   `validate:"gtefield=MinGuests"`
+	Price     float64 `validate:"ltfield=Limits.MaxPrice"`
+	Limits    Limits
+}
+
+type Limits struct {
+	MaxPrice int
+}
+
+// TestCrossFieldRules tests comparing field values with other fields of the struct.
+func TestCrossFieldRules(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	now := time.Now()
+	validBooking := Booking{
+		StartDate: "2024-04-01",
+		EndDate:   "2024-04-10",
+		CheckIn:   now,
+		CheckOut:  now.Add(time.Hour),
+		MinGuests: 1,
+		MaxGuests: 4,
+		Price:     99.5,
+		Limits:    Limits{MaxPrice: 100},
+	}
+
+	// Define test cases
+	testCases := []struct {
+		name   string      // Name of the test case
+		input  interface{} // Input struct to be validated
+		expect []string    // Expected "field:rule" of the failing fields
+	}{
+		{
+			name:   "MatchingPasswords",
+			input:  Signup{Password: "secret", PasswordConfirm: "secret", Username: "john"},
+			expect: nil,
+		},
+		{
+			name:   "MismatchingPasswords",
+			input:  Signup{Password: "secret", PasswordConfirm: "other", Username: "secret"},
+			expect: []string{"PasswordConfirm:eqfield", "Username:nefield"},
+		},
+		{
+			name:   "ValidBooking",
+			input:  validBooking,
+			expect: nil,
+		},
+		{
+			name: "InvalidBooking",
+			input: Booking{
+				StartDate: "2024-04-10",
+				EndDate:   "2024-04-01",
+				CheckIn:   now.Add(time.Hour),
+				CheckOut:  now,
+				MinGuests: 5,
+				MaxGuests: 4,
+				Price:     100,
+				Limits:    Limits{MaxPrice: 100},
+			},
+			expect: []string{"EndDate:gtfield", "CheckIn:ltefield", "MinGuests:ltefield", "MaxGuests:gtefield", "Price:ltfield"},
+		},
+	}
+
+	// Run test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateStruct(tc.input, "en")
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Field+":"+fieldErr.Rule)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing fields %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestCrossFieldMessage tests the localized message of a cross-field rule.
+func TestCrossFieldMessage(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	err := ValidateStruct(Signup{Password: "secret", PasswordConfirm: "other"}, "en")
+	if err == nil || err.Error() != "PasswordConfirm must be equal to Password" {
+		t.Errorf("Expected eqfield message, got '%v'", err)
+	}
+}
+
+// TestCrossFieldUnknownField tests that referencing an unknown field is reported.
+func TestCrossFieldUnknownField(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Invalid struct {
+		Value string `validate:"eqfield=Missing"`
+	}
+
+	err := ValidateStruct(Invalid{}, "en")
+	if err == nil || err.Error() != "unknown field for eqfield: Missing" {
+		t.Errorf("Expected unknown field error, got '%v'", err)
+	}
+}
+
+// TestCompareValues tests comparing values of different kinds.
+func TestCompareValues(t *testing.T) {
+	testCases := []struct {
+		name     string      // Name of the test case
+		a, b     interface{} // Values to compare
+		equality bool        // Whether only equality is checked
+		expected int         // Expected comparison result
+		wantErr  bool        // Whether an error is expected
+	}{
+		{name: "Ints", a: 1, b: 2, expected: -1},
+		{name: "IntAndUint", a: -1, b: uint64(1 << 63), expected: -1},
+		{name: "UintAndInt", a: uint(3), b: 3, expected: 0},
+		{name: "IntAndFloat", a: 2, b: 1.5, expected: 1},
+		{name: "Dates", a: "2024-12-01", b: "2024-02-01", expected: 1},
+		{name: "Strings", a: "apple", b: "banana", expected: -1},
+		{name: "Bools", a: true, b: true, equality: true, expected: 0},
+		{name: "OrderedBools", a: true, b: false, wantErr: true},
+		{name: "Mismatch", a: "1", b: 1, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := compareValues(reflect.ValueOf(tc.a), reflect.ValueOf(tc.b), tc.equality)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("compareValues() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.expected {
+				t.Errorf("compareValues() = %d, want %d", got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+const (
+	diveTag    = "dive"    // diveTag applies the following rules to each element of a slice, array or map
+	keysTag    = "keys"    // keysTag starts the rules applied to the keys of a map after a dive
+	endKeysTag = "endkeys" // endKeysTag ends the rules applied to the keys of a map
+)
+
+// dive applies the compiled dive rules to each element of a slice or array, or to each key and value of a map.
+// Element paths are indexed, e.g. "Items[3]" for slices and arrays and "Tags[color]" for maps.
+// Struct elements are validated recursively, so their fields get paths such as "Items[3].SKU".
+// If the value is not a slice, array or map, a validation error is recorded for the dive tag.
+func (v *validation) dive(ref fieldRef, path string, value reflect.Value, dive *diveChain) {
+	// Dereference pointers, nil collections have no elements to validate
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len() && !v.cancelled(); i++ {
+			v.validateElement(ref, indexPath(path, i), value.Index(i), dive.elems)
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(value) {
+			if v.cancelled() {
+				return
+			}
+			elemPath := keyPath(path, key)
+			v.applyRules(ref, elemPath, key, dive.keys)
+			v.validateElement(ref, elemPath, value.MapIndex(key), dive.elems)
+		}
+	default:
+		v.addError(ref.name, path, diveTag, "", value, fmt.Errorf("unsupported type for dive: %v", value.Kind()))
+	}
+}
+
+// checkDive returns an error if a rule chain dives into a type that is not a collection, e.g. "dive" on a string field.
+// Interface types, which may hold a collection, are checked when validating.
+func checkDive(chain *ruleChain, typ reflect.Type) error {
+	for chain != nil && chain.dive != nil {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		switch typ.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			chain, typ = chain.dive.elems, typ.Elem()
+		case reflect.Interface:
+			return nil
+		default:
+			return fmt.Errorf("%s on non-collection type %v", diveTag, typ)
+		}
+	}
+	return nil
+}
+
+// validateElement applies a rule chain to a collection element and validates struct elements recursively.
+func (v *validation) validateElement(ref fieldRef, path string, elem reflect.Value, chain *ruleChain) {
+	// Unwrap interface elements, e.g. the values of a map[string]interface{}
+	if elem.Kind() == reflect.Interface && !elem.IsNil() {
+		elem = elem.Elem()
+	}
+	v.applyRules(ref, path, elem, chain)
+	if nested, ok := structValue(elem); ok {
+		v.validateStruct(nested, path, false)
+	}
+}
+
+// indexPath returns the path of a slice or array element, e.g. "Items[3]".
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// keyPath returns the path of a map value, e.g. "Tags[color]".
+func keyPath(path string, key reflect.Value) string {
+	return fmt.Sprintf("%s[%v]", path, key.Interface())
+}
+
+// splitKeyTags splits the tags following a dive on a map into the tags for the keys and the tags for the values.
+// Key tags are enclosed by "keys" and "endkeys"; if the tags don't start with "keys", all tags apply to the values.
+func splitKeyTags(tags []tagItem) (keyTags, valueTags []tagItem) {
+	if len(tags) == 0 || tags[0].name != keysTag {
+		return nil, tags
+	}
+	for i := 1; i < len(tags); i++ {
+		if tags[i].name == endKeysTag {
+			return tags[1:i], tags[i+1:]
+		}
+	}
+	// Without "endkeys", all remaining tags apply to the keys
+	return tags[1:], nil
+}
+
+// sortedMapKeys returns the keys of a map sorted by their string representation,
+// so that validation errors are reported in a deterministic order.
+func sortedMapKeys(value reflect.Value) []reflect.Value {
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
@@ -0,0 +1,192 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type LineItem struct {
+	SKU string `validate:"required"`
+}
+
+type Order struct {
+	Items  []LineItem        `validate:"required,dive"`
+	Emails []string          `validate:"dive,email"`
+	Codes  [2]string         `validate:"dive,min=3"`
+	Tags   map[string]string `validate:"dive,keys,min=2,endkeys,required"`
+	Matrix [][]string        `validate:"dive,dive,required"`
+}
+
+// TestDive tests applying validation rules to the elements of slices, arrays and maps.
+func TestDive(t *testing.T) {
+	// Register default validation rules
+	RegisterDefaultValidationRules()
+
+	// Define test cases
+	testCases := []struct {
+		name   string   // Name of the test case
+		input  Order    // Input struct to be validated
+		expect []string // Expected "path:rule" of the failing elements
+	}{
+		{
+			name: "ValidOrder",
+			input: Order{
+				Items:  []LineItem{{SKU: "A1"}},
+				Emails: []string{"john@example.com"},
+				Codes:  [2]string{"abc", "def"},
+				Tags:   map[string]string{"color": "red"},
+				Matrix: [][]string{{"a", "b"}},
+			},
+			expect: nil,
+		},
+		{
+			name: "InvalidStructElement",
+			input: Order{
+				Items: []LineItem{{SKU: "A1"}, {}},
+				Codes: [2]string{"abc", "def"},
+			},
+			expect: []string{"Items[1].SKU:required"},
+		},
+		{
+			name: "InvalidSliceElement",
+			input: Order{
+				Items:  []LineItem{{SKU: "A1"}},
+				Emails: []string{"john@example.com", "invalid"},
+				Codes:  [2]string{"ab", "def"},
+			},
+			expect: []string{"Emails[1]:email", "Codes[0]:min"},
+		},
+		{
+			name: "InvalidMapKeyAndValue",
+			input: Order{
+				Items: []LineItem{{SKU: "A1"}},
+				Codes: [2]string{"abc", "def"},
+				Tags:  map[string]string{"c": "red", "size": ""},
+			},
+			expect: []string{"Tags[c]:min", "Tags[size]:required"},
+		},
+		{
+			name: "InvalidNestedSliceElement",
+			input: Order{
+				Items:  []LineItem{{SKU: "A1"}},
+				Codes:  [2]string{"abc", "def"},
+				Matrix: [][]string{{"a"}, {"b", ""}},
+			},
+			expect: []string{"Matrix[1][1]:required"},
+		},
+	}
+
+	// Run test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateStruct(tc.input, "en")
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			// Check the paths and rules of the failing elements
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Path+":"+fieldErr.Rule)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing elements %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestDiveUnsupportedType tests that diving into a value that is not a collection is reported,
+// as a malformed tag for struct fields, whose type is known when the tag is compiled.
+func TestDiveUnsupportedType(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name   string      // Name of the test case
+		input  interface{} // Input struct with a dive on a field that is not a collection
+		expect string      // Expected reason of the tag error
+	}{
+		{
+			name: "String",
+			input: struct {
+				Name string `validate:"dive,required"`
+			}{Name: "test"},
+			expect: "dive on non-collection type string",
+		},
+		{
+			name: "NestedDive",
+			input: struct {
+				Tags *[]string `validate:"dive,dive,required"`
+			}{},
+			expect: "dive on non-collection type string",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var tagErr *TagError
+			err := ValidateStruct(tc.input, "en")
+			if !errors.As(err, &tagErr) || tagErr.Reason != tc.expect {
+				t.Errorf("Expected tag error '%s', got '%v'", tc.expect, err)
+			}
+		})
+	}
+
+	// Interface fields may hold a collection and are checked when validating
+	type Payload struct {
+		Data interface{} `validate:"dive,required"`
+	}
+	if err := ValidateStruct(Payload{Data: []string{"a"}}, "en"); err != nil {
+		t.Errorf("Expected no error, got '%v'", err)
+	}
+	var validationErrors ValidationErrors
+	err := ValidateStruct(Payload{Data: "test"}, "en")
+	if !errors.As(err, &validationErrors) || validationErrors[0].Rule != "dive" {
+		t.Errorf("Expected dive error, got '%v'", err)
+	}
+}
+
+// TestSplitKeyTags tests splitting map dive tags into key and value tags.
+func TestSplitKeyTags(t *testing.T) {
+	testCases := []struct {
+		name      string   // Name of the test case
+		tag       string   // Tag following the dive
+		keyTags   []string // Expected key tags
+		valueTags []string // Expected value tags
+	}{
+		{name: "ValuesOnly", tag: "required", keyTags: nil, valueTags: []string{"required"}},
+		{name: "KeysAndValues", tag: "keys,min=2,endkeys,required", keyTags: []string{"min=2"}, valueTags: []string{"required"}},
+		{name: "KeysWithoutEnd", tag: "keys,min=2", keyTags: []string{"min=2"}, valueTags: nil},
+	}
+
+	// rawTags returns the tags of the given items
+	rawTags := func(items []tagItem) []string {
+		var tags []string
+		for _, item := range items {
+			tags = append(tags, item.raw())
+		}
+		return tags
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := parseTag(tc.tag)
+			if err != nil {
+				t.Fatalf("Expected no error, got '%v'", err)
+			}
+			keyItems, valueItems := splitKeyTags(items)
+			keyTags, valueTags := rawTags(keyItems), rawTags(valueItems)
+			if !reflect.DeepEqual(keyTags, tc.keyTags) || !reflect.DeepEqual(valueTags, tc.valueTags) {
+				t.Errorf("Expected %v/%v, got %v/%v", tc.keyTags, tc.valueTags, keyTags, valueTags)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package validator
+
+import (
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+	"reflect"
+	"regexp"
+)
+
+// e164Regex matches phone numbers in the E.164 format: a "+" followed by up to 15 digits, not starting with 0.
+var e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// validateE164 validates if the provided string is a phone number in the E.164 format, such as "+905551234567".
+// The fieldName parameter is used to customize the error message to include the name of the field being validated.
+// The rule parameter is not used in this function but is included for consistency with other validation functions.
+func validateE164(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
+	if value.Kind() != reflect.String || !e164Regex.MatchString(value.String()) {
+		return NewRuleError(messages, "invalidE164", fieldName)
+	}
+	return nil
+}
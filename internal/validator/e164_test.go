@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+	"reflect"
+	"testing"
+)
+
+// TestValidateE164 tests the validateE164 function.
+func TestValidateE164(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name  string      // Test case name
+		value interface{} // Input value
+		valid bool        // Whether the value is expected to be valid
+	}{
+		{name: "Valid", value: "+905551234567", valid: true},
+		{name: "Shortest", value: "+12", valid: true},
+		{name: "MissingPlus", value: "905551234567", valid: false},
+		{name: "LeadingZero", value: "+05551234567", valid: false},
+		{name: "TooLong", value: "+1234567890123456", valid: false},
+		{name: "Spaces", value: "+90 555 123 4567", valid: false},
+		{name: "NotString", value: 905551234567, valid: false},
+	}
+
+	// Mock error messages for localization
+	errorMessages := locales.ErrorMessages{
+		"invalidE164": "{field} must be a valid phone number",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateE164(reflect.ValueOf(tt.value), errorMessages, "Phone", "e164")
+			if tt.valid && err != nil {
+				t.Errorf("Expected no error, got '%v'", err)
+			}
+			if !tt.valid && (err == nil || err.Error() != "Phone must be a valid phone number") {
+				t.Errorf("Expected invalid phone number error, got '%v'", err)
+			}
+		})
+	}
+}
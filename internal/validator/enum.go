@@ -0,0 +1,149 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// validateOneOf validates if a value is one of the values given as rule parameter, e.g. "oneof=draft published".
+// Values containing spaces can be quoted, e.g. "oneof='New York' Paris".
+// Without a parameter, the value is validated against its own type, see validateEnum.
+func validateOneOf(rc *RuleContext) error {
+	return matchValues(rc, "oneOf", true, false)
+}
+
+// validateNoneOf validates if a value is none of the values given as rule parameter, e.g. "noneof=admin root".
+func validateNoneOf(rc *RuleContext) error {
+	return matchValues(rc, "noneOf", false, false)
+}
+
+// validateOneOfCI validates if a value is one of the values given as rule parameter, ignoring case.
+func validateOneOfCI(rc *RuleContext) error {
+	return matchValues(rc, "oneOf", true, true)
+}
+
+// validateNoneOfCI validates if a value is none of the values given as rule parameter, ignoring case.
+func validateNoneOfCI(rc *RuleContext) error {
+	return matchValues(rc, "noneOf", false, true)
+}
+
+// matchValues compares the string representation of the value of the rule context with the arguments of the rule,
+// and returns the error message with the given key if the value is not among them and should be, or the other way around.
+func matchValues(rc *RuleContext, key string, allowed, ignoreCase bool) error {
+	values := ruleArgs(rc)
+	if len(values) == 0 {
+		if allowed && !ignoreCase {
+			return validateEnum(rc)
+		}
+		return fmt.Errorf("missing parameter for %s", rc.Rule)
+	}
+
+	value := fieldString(rc.Value)
+	found := false
+	for _, candidate := range values {
+		if candidate == value || (ignoreCase && strings.EqualFold(candidate, value)) {
+			found = true
+			break
+		}
+	}
+
+	if found != allowed {
+		return NewRuleError(rc.Messages, key, rc.Field, locales.Param{Name: "values", Value: strings.Join(values, ", ")})
+	}
+	return nil
+}
+
+// validateEnum validates a value against its own type, for "oneof" without parameter.
+// Types with an IsValid() bool method are valid if it returns true, and types with a Values() method returning
+// a slice of the type, such as func (Status) Values() []Status, are valid if the value is among them.
+// If the type has both methods, IsValid decides and Values lists the allowed values in the error message.
+func validateEnum(rc *RuleContext) error {
+	value := indirect(rc.Value)
+	if !value.IsValid() {
+		return nil
+	}
+
+	isValid, hasIsValid := enumMethod(value, "IsValid")
+	valuesFunc, hasValues := enumMethod(value, "Values")
+	if !hasIsValid && !hasValues {
+		return fmt.Errorf("missing parameter for %s: %v has no IsValid or Values method", rc.Rule, value.Type())
+	}
+
+	var values []reflect.Value
+	if hasValues {
+		var err error
+		if values, err = enumValues(valuesFunc); err != nil {
+			return fmt.Errorf("invalid Values method for %s: %v", rc.Rule, err)
+		}
+	}
+
+	var valid bool
+	if hasIsValid {
+		results := isValid.Call(nil)
+		if len(results) != 1 || results[0].Kind() != reflect.Bool {
+			return fmt.Errorf("invalid IsValid method for %s: must return a bool", rc.Rule)
+		}
+		valid = results[0].Bool()
+	} else {
+		valid = containsValue(values, value)
+	}
+
+	if valid {
+		return nil
+	}
+	if !hasValues {
+		return NewRuleError(rc.Messages, "invalidValue", rc.Field)
+	}
+
+	names := make([]string, len(values))
+	for i, allowed := range values {
+		names[i] = fieldString(allowed)
+	}
+	return NewRuleError(rc.Messages, "oneOf", rc.Field, locales.Param{Name: "values", Value: strings.Join(names, ", ")})
+}
+
+// enumMethod returns the method with the given name and no arguments of a value,
+// taking the address of a copy of the value for methods with a pointer receiver.
+func enumMethod(value reflect.Value, name string) (reflect.Value, bool) {
+	if !value.IsValid() || !value.CanInterface() {
+		return reflect.Value{}, false
+	}
+
+	method := value.MethodByName(name)
+	if !method.IsValid() && value.Kind() != reflect.Ptr {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		method = ptr.MethodByName(name)
+	}
+	if !method.IsValid() || method.Type().NumIn() != 0 {
+		return reflect.Value{}, false
+	}
+	return method, true
+}
+
+// enumValues calls a Values method and returns the values of the slice it returns.
+func enumValues(valuesFunc reflect.Value) ([]reflect.Value, error) {
+	results := valuesFunc.Call(nil)
+	if len(results) != 1 || (results[0].Kind() != reflect.Slice && results[0].Kind() != reflect.Array) {
+		return nil, fmt.Errorf("must return a slice")
+	}
+
+	values := make([]reflect.Value, results[0].Len())
+	for i := range values {
+		values[i] = results[0].Index(i)
+	}
+	return values, nil
+}
+
+// containsValue reports whether a value is equal to one of the given values.
+func containsValue(values []reflect.Value, value reflect.Value) bool {
+	for _, candidate := range values {
+		if candidate.Type() == value.Type() && reflect.DeepEqual(candidate.Interface(), value.Interface()) {
+			return true
+		}
+	}
+	return false
+}
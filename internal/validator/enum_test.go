@@ -0,0 +1,134 @@
+package validator
+
+import (
+	"testing"
+)
+
+// Status is an enumeration listing its values.
+type Status string
+
+// Values returns the valid statuses.
+func (Status) Values() []Status {
+	return []Status{"draft", "published"}
+}
+
+// Level is an enumeration with a validity check on a pointer receiver.
+type Level int
+
+// IsValid reports whether the level is between 1 and 3.
+func (l *Level) IsValid() bool {
+	return *l >= 1 && *l <= 3
+}
+
+// Color is an enumeration with both a validity check and a list of values.
+type Color string
+
+// IsValid reports whether the color is red or blue, in lower or upper case.
+func (c Color) IsValid() bool {
+	return c == "red" || c == "blue" || c == "RED" || c == "BLUE"
+}
+
+// Values returns the valid colors.
+func (Color) Values() []Color {
+	return []Color{"red", "blue"}
+}
+
+// TestEnumRules tests validating values against a list of values given in the tag or by their type.
+func TestEnumRules(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	level := Level(2)
+	var noStatus *Status
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		expected string      // Expected error message, empty if validation passes
+	}{
+		{name: "OneOf", input: "green", tag: "oneof=red green blue"},
+		{name: "NotOneOf", input: "pink", tag: "oneof=red green blue", expected: "Value must be one of: red, green, blue"},
+		{name: "OneOfQuoted", input: "New York", tag: "oneof='New York' Paris"},
+		{name: "OneOfQuotedPart", input: "New", tag: "oneof='New York' Paris", expected: "Value must be one of: New York, Paris"},
+		{name: "OneOfCaseSensitive", input: "Red", tag: "oneof=red green", expected: "Value must be one of: red, green"},
+		{name: "OneOfInt", input: 3, tag: "oneof=1 2 3"},
+		{name: "NotOneOfInt", input: 4, tag: "oneof=1 2 3", expected: "Value must be one of: 1, 2, 3"},
+		{name: "NoneOf", input: "alice", tag: "noneof=admin root"},
+		{name: "NotNoneOf", input: "root", tag: "noneof=admin root", expected: "Value must not be one of: admin, root"},
+		{name: "OneOfCI", input: "GREEN", tag: "oneofci=red green"},
+		{name: "NotOneOfCI", input: "pink", tag: "oneofci=red green", expected: "Value must be one of: red, green"},
+		{name: "NoneOfCI", input: "Root", tag: "noneofci=admin root", expected: "Value must not be one of: admin, root"},
+		{name: "Values", input: Status("draft"), tag: "oneof"},
+		{name: "NotValues", input: Status("deleted"), tag: "oneof", expected: "Value must be one of: draft, published"},
+		{name: "ValuesNilPointer", input: noStatus, tag: "oneof", expected: "Value must be one of: draft, published"},
+		{name: "ValuesOmitEmpty", input: noStatus, tag: "omitempty,oneof"},
+		{name: "IsValid", input: level, tag: "oneof"},
+		{name: "IsValidPointer", input: &level, tag: "oneof"},
+		{name: "NotIsValid", input: Level(5), tag: "oneof", expected: "Value is not a valid value"},
+		{name: "IsValidAndValues", input: Color("RED"), tag: "oneof"},
+		{name: "NotIsValidAndValues", input: Color("pink"), tag: "oneof", expected: "Value must be one of: red, blue"},
+		{name: "ValuesWithParameter", input: Status("archived"), tag: "oneof=draft archived"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.ValidateVar("", tc.input, tc.tag, Options{})
+			if tc.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestEnumRuleErrors tests that missing parameters are reported.
+func TestEnumRuleErrors(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		expected string      // Expected error message
+	}{
+		{name: "OneOfString", input: "red", tag: "oneof", expected: "missing parameter for oneof: string has no IsValid or Values method"},
+		{name: "NoneOf", input: Status("draft"), tag: "noneof", expected: "missing parameter for noneof"},
+		{name: "OneOfCI", input: Status("draft"), tag: "oneofci", expected: "missing parameter for oneofci"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.ValidateVar("", tc.input, tc.tag, Options{})
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestEnumMessages tests the allowed values in localized messages and error parameters.
+func TestEnumMessages(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Article struct {
+		Status Status `validate:"oneof"`
+		Lang   string `validate:"oneof=en tr"`
+	}
+
+	err := ValidateStruct(Article{Status: "deleted", Lang: "de"}, "tr")
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected 2 validation errors, got '%v'", err)
+	}
+	if expected := "Status şunlardan biri olmalıdır: draft, published"; errs[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, errs[0].Message)
+	}
+	if values := errs[1].Params["values"]; values != "en, tr" {
+		t.Errorf("Expected values parameter 'en, tr', got '%v'", values)
+	}
+}
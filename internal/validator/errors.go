@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// FieldError describes a single validation failure.
+// It carries enough information for callers to map the failure back to the offending field,
+// for example when building per-field JSON error responses.
+type FieldError struct {
+	Field   string                 // Field is the name of the struct field that failed validation
+	Path    string                 // Path is the dotted path of the field from the validated struct (e.g. "Address.City")
+	Rule    string                 // Rule is the name of the validation rule that failed (e.g. "min")
+	Param   string                 // Param is the rule parameter (e.g. "8" for "min=8"), empty if the rule has none
+	Value   interface{}            // Value is the offending field value
+	Message string                 // Message is the localized error message
+	Params  map[string]interface{} // Params holds the named parameters of the message (e.g. "min": 8), nil if the rule reports none
+}
+
+// Error returns the localized error message of the field error.
+func (e FieldError) Error() string {
+	return e.Message
+}
+
+// ValidationErrors is a collection of field errors returned when validation fails.
+// It implements the error interface, so it can be retrieved from a returned error with errors.As.
+type ValidationErrors []FieldError
+
+// Error returns the localized messages of all field errors joined by ";\n".
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Message
+	}
+	return strings.Join(messages, ";\n")
+}
+
+// Unwrap returns the individual field errors, allowing errors.As to match a single FieldError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fieldErr := range e {
+		errs[i] = fieldErr
+	}
+	return errs
+}
+
+// RuleError is returned by validation rules to report a failure with a localized message and its parameters.
+// The parameters are exposed on the resulting FieldError, e.g. to include the allowed values in a JSON response.
+type RuleError struct {
+	Key     string                 // Key is the message key (e.g. "minLength")
+	Message string                 // Message is the rendered localized message
+	Params  map[string]interface{} // Params holds the named parameters of the message
+}
+
+// Error returns the rendered localized message.
+func (e *RuleError) Error() string {
+	return e.Message
+}
+
+// NewRuleError renders the message with the given key for a field and returns it as a RuleError.
+// The message template may use named placeholders such as {field} and {min}, or legacy printf verbs.
+func NewRuleError(messages locales.ErrorMessages, key, field string, params ...locales.Param) *RuleError {
+	var named map[string]interface{}
+	if len(params) > 0 {
+		named = make(map[string]interface{}, len(params))
+		for _, param := range params {
+			named[param.Name] = param.Value
+		}
+	}
+	return &RuleError{
+		Key:     key,
+		Message: messages.Format(key, field, params...),
+		Params:  named,
+	}
+}
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// TestValidationErrorsError tests that the messages of all field errors are joined.
+func TestValidationErrorsError(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "Username", Rule: "required", Message: "Username is required"},
+		{Field: "Password", Rule: "min", Param: "8", Message: "Password must be at least 8 characters long"},
+	}
+
+	expected := "Username is required;\nPassword must be at least 8 characters long"
+	if errs.Error() != expected {
+		t.Errorf("Expected error message '%s', got '%s'", expected, errs.Error())
+	}
+}
+
+// TestValidationErrorsAs tests that ValidationErrors and FieldError can be retrieved with errors.As.
+func TestValidationErrorsAs(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	// Validate an invalid user
+	err := ValidateStruct(User{Username: "test", Password: "weak"}, "en")
+
+	// Retrieve the collection of field errors
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected error of type ValidationErrors, got %T", err)
+	}
+	if len(validationErrors) != 1 {
+		t.Fatalf("Expected 1 field error, got %d", len(validationErrors))
+	}
+
+	// Check the details of the field error
+	expected := FieldError{
+		Field:   "Password",
+		Path:    "Password",
+		Rule:    "min",
+		Param:   "8",
+		Value:   "weak",
+		Message: "Password must be at least 8 characters long",
+		Params:  map[string]interface{}{"min": 8},
+	}
+	if !reflect.DeepEqual(validationErrors[0], expected) {
+		t.Errorf("Expected field error %+v, got %+v", expected, validationErrors[0])
+	}
+
+	// Retrieve a single field error
+	var fieldErr FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected error to wrap a FieldError")
+	}
+	if fieldErr.Field != "Password" {
+		t.Errorf("Expected field 'Password', got '%s'", fieldErr.Field)
+	}
+}
+
+// TestNewRuleError tests rendering a rule error with named and legacy message templates.
+func TestNewRuleError(t *testing.T) {
+	testCases := []struct {
+		name     string // Name of the test case
+		template string // Message template
+		expected string // Expected message
+	}{
+		{name: "Named", template: "{field} must be at least {min} characters long", expected: "Password must be at least 8 characters long"},
+		{name: "Reordered", template: "En az {min} karakter: {field}", expected: "En az 8 karakter: Password"},
+		{name: "Legacy", template: "%s must be at least %d characters long", expected: "Password must be at least 8 characters long"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			messages := locales.ErrorMessages{"minLength": tc.template}
+			err := NewRuleError(messages, "minLength", "Password", locales.Param{Name: "min", Value: 8})
+			if err.Error() != tc.expected {
+				t.Errorf("Expected message '%s', got '%s'", tc.expected, err.Error())
+			}
+			if err.Key != "minLength" || !reflect.DeepEqual(err.Params, map[string]interface{}{"min": 8}) {
+				t.Errorf("Unexpected rule error %+v", err)
+			}
+		})
+	}
+}
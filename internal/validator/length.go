@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// lengthMode selects how the length of a string is measured by the length rules.
+type lengthMode int
+
+const (
+	lengthRunes     lengthMode = iota // lengthRunes counts Unicode code points, so "Çağrı" has a length of 5
+	lengthBytes                       // lengthBytes counts UTF-8 bytes, so "Çağrı" has a length of 8
+	lengthGraphemes                   // lengthGraphemes counts user-perceived characters, so "👍🏽" has a length of 1
+)
+
+// lengthModes maps the length modes accepted after the length of a rule, e.g. "max=20 graphemes".
+var lengthModes = map[string]lengthMode{
+	"runes":     lengthRunes,
+	"bytes":     lengthBytes,
+	"graphemes": lengthGraphemes,
+}
+
+// zeroWidthJoiner joins emoji into a single grapheme cluster, e.g. the family emoji.
+const zeroWidthJoiner = '\u200d'
+
+// stringLength returns the length of a string measured in the given mode.
+func stringLength(s string, mode lengthMode) int {
+	switch mode {
+	case lengthBytes:
+		return len(s)
+	case lengthGraphemes:
+		return graphemeCount(s)
+	default:
+		return utf8.RuneCountInString(s)
+	}
+}
+
+// graphemeCount returns the number of grapheme clusters of a string, i.e. the characters perceived by users.
+// It approximates the Unicode segmentation rules: combining marks, variation selectors, emoji modifiers
+// and Hangul vowel and final jamo extend the preceding character, a zero-width joiner joins the characters
+// around it, regional indicators are paired into flags, and "\r\n" counts as a single character.
+func graphemeCount(s string) int {
+	count := 0
+	prev := rune(-1)
+	regional := 0 // regional is the number of consecutive regional indicators preceding the rune
+	for _, r := range s {
+		extends := prev >= 0 &&
+			(prev == zeroWidthJoiner || isGraphemeExtend(r) || (prev == '\r' && r == '\n') ||
+				(isRegionalIndicator(r) && regional%2 == 1))
+		if !extends {
+			count++
+		}
+
+		if isRegionalIndicator(r) {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = r
+	}
+	return count
+}
+
+// isGraphemeExtend reports whether a rune extends the grapheme cluster of the preceding rune.
+func isGraphemeExtend(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner:
+		return true
+	case r >= 0x1f3fb && r <= 0x1f3ff: // Emoji skin tone modifiers
+		return true
+	case r >= 0xe0020 && r <= 0xe007f: // Tag characters of subdivision flags
+		return true
+	case r >= 0x1160 && r <= 0x11ff: // Hangul vowel and final jamo
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// isRegionalIndicator reports whether a rune is a regional indicator, two of which form a flag.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
@@ -0,0 +1,82 @@
+package locales
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Param is a named parameter of an error message, referenced in message templates as {name}.
+type Param struct {
+	Name  string      // Name is the name of the placeholder, e.g. "min" for {min}
+	Value interface{} // Value is the value substituted for the placeholder
+}
+
+// Format renders the message with the given key for a field and its rule parameters.
+// If the messages don't contain the key, the key itself is used as the template.
+func (m ErrorMessages) Format(key, field string, params ...Param) string {
+	template, ok := m[key]
+	if !ok {
+		template = key
+	}
+	return Format(template, field, params...)
+}
+
+// Format renders a message template for a field and its rule parameters.
+//
+// Templates use named placeholders, such as "{field} must be at least {min} characters long":
+// {field} is replaced by the field name and every other placeholder by the value of the parameter with the same name.
+// Placeholders without a matching parameter are kept as they are, so translators can reorder arguments freely.
+//
+// Legacy printf-style templates without named placeholders, such as "%s must be at least %d characters long",
+// are still supported: they are formatted with the field name followed by the parameter values in order.
+func Format(template, field string, params ...Param) string {
+	if !strings.Contains(template, "{") {
+		if !strings.Contains(template, "%") {
+			return template
+		}
+		args := make([]interface{}, 0, len(params)+1)
+		args = append(args, field)
+		for _, param := range params {
+			args = append(args, param.Value)
+		}
+		return fmt.Sprintf(template, args...)
+	}
+
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		b.WriteString(template[:start])
+		if value, ok := lookupParam(template[start+1:end], field, params); ok {
+			b.WriteString(value)
+		} else {
+			// Keep unknown placeholders as they are
+			b.WriteString(template[start : end+1])
+		}
+		template = template[end+1:]
+	}
+	b.WriteString(template)
+
+	return b.String()
+}
+
+// lookupParam returns the formatted value of a named placeholder.
+func lookupParam(name, field string, params []Param) (string, bool) {
+	if name == "field" {
+		return field, true
+	}
+	for _, param := range params {
+		if param.Name == name {
+			return fmt.Sprint(param.Value), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,68 @@
+package locales
+
+import "testing"
+
+// TestFormat tests rendering named and legacy printf-style message templates.
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		name     string  // Name of the test case
+		template string  // Message template
+		params   []Param // Rule parameters
+		expected string  // Expected message
+	}{
+		{
+			name:     "Named",
+			template: "{field} must be at least {min} characters long",
+			params:   []Param{{Name: "min", Value: 8}},
+			expected: "Password must be at least 8 characters long",
+		},
+		{
+			name:     "Reordered",
+			template: "{min} karakterden kısa olamaz: {field}",
+			params:   []Param{{Name: "min", Value: 8}},
+			expected: "8 karakterden kısa olamaz: Password",
+		},
+		{
+			name:     "UnknownPlaceholder",
+			template: "{field} must be one of {values} ({unknown})",
+			params:   []Param{{Name: "values", Value: "a, b"}},
+			expected: "Password must be one of a, b ({unknown})",
+		},
+		{
+			name:     "UnterminatedPlaceholder",
+			template: "{field} is {invalid",
+			expected: "Password is {invalid",
+		},
+		{
+			name:     "Legacy",
+			template: "%s must be at least %d characters long",
+			params:   []Param{{Name: "min", Value: 8}},
+			expected: "Password must be at least 8 characters long",
+		},
+		{
+			name:     "Plain",
+			template: "Invalid value",
+			expected: "Invalid value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Format(tc.template, "Password", tc.params...); got != tc.expected {
+				t.Errorf("Format(%q) = %q, want %q", tc.template, got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestErrorMessagesFormat tests rendering a message by key, falling back to the key if it is missing.
+func TestErrorMessagesFormat(t *testing.T) {
+	messages := ErrorMessages{"required": "{field} is required"}
+
+	if got := messages.Format("required", "Name"); got != "Name is required" {
+		t.Errorf("Expected 'Name is required', got %q", got)
+	}
+	if got := messages.Format("missing", "Name"); got != "missing" {
+		t.Errorf("Expected 'missing', got %q", got)
+	}
+}
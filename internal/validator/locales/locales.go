@@ -1,14 +1,19 @@
 package locales
 
 import (
+	"embed"
 	"encoding/json"
-	"errors"
-	"os"
-	"path/filepath"
-	"runtime"
+	"io"
+	"io/fs"
 	"sync"
 )
 
+// files holds the bundled locale files, compiled into the binary so that
+// messages can be loaded without the module source tree being present.
+//
+//go:embed *.json
+var files embed.FS
+
 // ErrorMessages represents a collection of error messages.
 type ErrorMessages map[string]string
 
@@ -21,12 +26,13 @@ func init() {
 	cache = make(map[string]ErrorMessages)
 }
 
-// LoadMessagesFromJSON loads error messages from a JSON file based on the specified language.
-// It reads the JSON file located in the "internal/validator/locales" directory and unmarshal it into an ErrorMessages map.
+// LoadMessagesFromJSON loads error messages from a bundled JSON file based on the specified language.
+// The JSON files in the "internal/validator/locales" directory are embedded into the binary,
+// and the file matching the language is unmarshalled into an ErrorMessages map.
 // The language parameter specifies the language code (e.g., "en" for English).
 //
 // If the messages for the specified language are already loaded, it returns them from the cache.
-// Otherwise, it loads the messages from the JSON file, caches them, and returns.
+// Otherwise, it loads the messages from the embedded JSON file, caches them, and returns.
 func LoadMessagesFromJSON(lang string) (ErrorMessages, error) {
 	// Check cache first to avoid unnecessary file reads
 	cacheMutex.RLock()
@@ -36,25 +42,15 @@ func LoadMessagesFromJSON(lang string) (ErrorMessages, error) {
 	}
 	cacheMutex.RUnlock()
 
-	// Get the directory of the package
-	_, filename, _, ok := runtime.Caller(0)
-	if !ok {
-		return nil, errors.New("failed to get package directory")
-	}
-	dir := filepath.Dir(filename)
-
-	// Construct the path to the JSON file based on the specified language
-	filename = filepath.Join(dir, lang+".json")
-
-	// Read the content of the JSON file
-	data, err := os.ReadFile(filename)
+	// Read the content of the embedded JSON file for the specified language
+	data, err := files.ReadFile(lang + ".json")
 	if err != nil {
 		return nil, err // Return the error if unable to read the file
 	}
 
 	// Unmarshal the JSON data into the ErrorMessages struct
-	var messages ErrorMessages
-	if err := json.Unmarshal(data, &messages); err != nil {
+	messages, err := unmarshalMessages(data)
+	if err != nil {
 		return nil, err // Return the error if unable to unmarshal JSON data
 	}
 
@@ -65,3 +61,32 @@ func LoadMessagesFromJSON(lang string) (ErrorMessages, error) {
 
 	return messages, nil // Return the loaded error messages
 }
+
+// LoadMessagesFromFS loads error messages for the specified language from a JSON file in a file system.
+// The file is named after the language (e.g., "de.json" for German) and is located in the root of the file system.
+// Unlike LoadMessagesFromJSON, the loaded messages are not cached.
+func LoadMessagesFromFS(fsys fs.FS, lang string) (ErrorMessages, error) {
+	data, err := fs.ReadFile(fsys, lang+".json")
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMessages(data)
+}
+
+// ParseMessages reads error messages from a reader containing a JSON object of message keys and messages.
+func ParseMessages(r io.Reader) (ErrorMessages, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMessages(data)
+}
+
+// unmarshalMessages unmarshals a JSON object of message keys and messages.
+func unmarshalMessages(data []byte) (ErrorMessages, error) {
+	var messages ErrorMessages
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
@@ -1,7 +1,12 @@
 package locales
 
 import (
+	"encoding/json"
+	"io/fs"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 // TestLoadMessagesFromJSON tests the LoadMessagesFromJSON function.
@@ -62,3 +67,70 @@ func TestCacheRetrieval(t *testing.T) {
 		}
 	}
 }
+
+// TestLoadMessagesWithoutSourceDirectory tests that the bundled messages are loaded from the files embedded
+// into the binary, without reading the locale files from the source directory.
+func TestLoadMessagesWithoutSourceDirectory(t *testing.T) {
+	// Clear the cache so that the messages are loaded again
+	cacheMutex.Lock()
+	delete(cache, "tr")
+	cacheMutex.Unlock()
+
+	messages, err := LoadMessagesFromJSON("tr")
+	if err != nil {
+		t.Fatalf("Unexpected error for language tr: %v", err)
+	}
+
+	data, err := files.ReadFile("tr.json")
+	if err != nil {
+		t.Fatalf("Failed to read embedded tr.json: %v", err)
+	}
+	var expected ErrorMessages
+	if err := json.Unmarshal(data, &expected); err != nil {
+		t.Fatalf("Failed to decode embedded tr.json: %v", err)
+	}
+	if len(expected) == 0 || !reflect.DeepEqual(messages, expected) {
+		t.Errorf("Expected the messages of the embedded tr.json, got %v", messages)
+	}
+}
+
+// TestEmbeddedLocales tests that the bundled locale files are embedded into the binary.
+func TestEmbeddedLocales(t *testing.T) {
+	for _, name := range []string{"en.json", "tr.json"} {
+		if _, err := fs.Stat(files, name); err != nil {
+			t.Errorf("Expected %s to be embedded: %v", name, err)
+		}
+	}
+}
+
+// TestParseMessages tests reading messages from a JSON reader.
+func TestParseMessages(t *testing.T) {
+	messages, err := ParseMessages(strings.NewReader(`{"required": "%s is required"}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if messages["required"] != "%s is required" {
+		t.Errorf("Unexpected messages %v", messages)
+	}
+
+	if _, err := ParseMessages(strings.NewReader(`not json`)); err == nil {
+		t.Errorf("Expected error for invalid JSON, got none")
+	}
+}
+
+// TestLoadMessagesFromFS tests loading messages for a language from a file system.
+func TestLoadMessagesFromFS(t *testing.T) {
+	fsys := fstest.MapFS{"de.json": {Data: []byte(`{"required": "%s ist erforderlich"}`)}}
+
+	messages, err := LoadMessagesFromFS(fsys, "de")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if messages["required"] != "%s ist erforderlich" {
+		t.Errorf("Unexpected messages %v", messages)
+	}
+
+	if _, err := LoadMessagesFromFS(fsys, "fr"); err == nil {
+		t.Errorf("Expected error for missing language, got none")
+	}
+}
@@ -0,0 +1,156 @@
+package locales
+
+import (
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NormalizeTag normalizes a BCP 47 language tag to its canonical casing and separator.
+// Underscores are accepted as separators, so "pt_br", "PT-BR" and "pt-BR" are all normalized to "pt-BR".
+// The language subtag is lowercased, script subtags are titlecased (e.g. "Hant"),
+// region subtags are uppercased (e.g. "BR"), and other subtags are lowercased.
+func NormalizeTag(tag string) string {
+	subtags := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	for i, subtag := range subtags {
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2 && isAlpha(subtag), len(subtag) == 3 && isDigit(subtag):
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// FallbackChain returns the languages to look up messages for, from the most to the least specific.
+// The chain starts with the normalized tag, followed by the tag with its trailing subtags removed one by one,
+// then the fallback chain of the default language, and finally English.
+// For example, "pt_BR" with the default language "es" gives ["pt-BR", "pt", "es", "en"].
+func FallbackChain(tag, defaultLang string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	for _, lang := range []string{tag, defaultLang, "en"} {
+		lang = NormalizeTag(lang)
+		for lang != "" {
+			if !seen[lang] {
+				seen[lang] = true
+				chain = append(chain, lang)
+			}
+			i := strings.LastIndex(lang, "-")
+			if i < 0 {
+				break
+			}
+			lang = lang[:i]
+		}
+	}
+	return chain
+}
+
+// ParseAcceptLanguage parses the value of an HTTP Accept-Language header, such as "tr-TR,tr;q=0.9,en;q=0.8".
+// It returns the normalized language tags ordered by decreasing quality value, keeping the header order for equal values.
+// The wildcard "*", tags with a quality value of 0 and malformed entries are ignored.
+func ParseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weightedTag
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		// Parse the quality value, which defaults to 1
+		quality := 1.0
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil {
+				quality = 0
+				break
+			}
+			quality = q
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		tags = append(tags, weightedTag{tag: NormalizeTag(tag), quality: quality})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	result := make([]string, len(tags))
+	for i, tag := range tags {
+		result[i] = tag.tag
+	}
+	return result
+}
+
+// MatchAcceptLanguage picks the best available language for an HTTP Accept-Language header.
+// Each requested language is matched against the available languages with its trailing subtags removed one by one,
+// so "pt-BR" matches an available "pt". It returns false if no requested language is available.
+func MatchAcceptLanguage(header string, available []string) (string, bool) {
+	supported := make(map[string]string, len(available))
+	for _, lang := range available {
+		supported[NormalizeTag(lang)] = lang
+	}
+
+	for _, tag := range ParseAcceptLanguage(header) {
+		for lang := tag; lang != ""; {
+			if match, ok := supported[lang]; ok {
+				return match, true
+			}
+			i := strings.LastIndex(lang, "-")
+			if i < 0 {
+				break
+			}
+			lang = lang[:i]
+		}
+	}
+	return "", false
+}
+
+// BundledLanguages returns the languages of the bundled locale files, such as "en" and "tr".
+func BundledLanguages() []string {
+	names, _ := fs.Glob(files, "*.json")
+	langs := make([]string, len(names))
+	for i, name := range names {
+		langs[i] = strings.TrimSuffix(name, ".json")
+	}
+	return langs
+}
+
+// isAlpha reports whether a string consists of ASCII letters only.
+func isAlpha(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigit reports whether a string consists of ASCII digits only.
+func isDigit(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
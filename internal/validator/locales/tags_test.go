@@ -0,0 +1,105 @@
+package locales
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestNormalizeTag tests normalizing BCP 47 language tags.
+func TestNormalizeTag(t *testing.T) {
+	testCases := []struct {
+		tag      string // Input tag
+		expected string // Expected normalized tag
+	}{
+		{tag: "en", expected: "en"},
+		{tag: "EN", expected: "en"},
+		{tag: "pt_br", expected: "pt-BR"},
+		{tag: "tr-TR", expected: "tr-TR"},
+		{tag: "zh-hant-tw", expected: "zh-Hant-TW"},
+		{tag: "es-419", expected: "es-419"},
+		{tag: " de ", expected: "de"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			if got := NormalizeTag(tc.tag); got != tc.expected {
+				t.Errorf("NormalizeTag(%q) = %q, want %q", tc.tag, got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestFallbackChain tests building the fallback chain of a language.
+func TestFallbackChain(t *testing.T) {
+	testCases := []struct {
+		name        string   // Name of the test case
+		tag         string   // Requested language
+		defaultLang string   // Default language
+		expected    []string // Expected fallback chain
+	}{
+		{name: "Region", tag: "pt_BR", defaultLang: "es", expected: []string{"pt-BR", "pt", "es", "en"}},
+		{name: "Script", tag: "zh-Hant-TW", defaultLang: "", expected: []string{"zh-Hant-TW", "zh-Hant", "zh", "en"}},
+		{name: "English", tag: "en-GB", defaultLang: "en", expected: []string{"en-GB", "en"}},
+		{name: "Empty", tag: "", defaultLang: "tr", expected: []string{"tr", "en"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := FallbackChain(tc.tag, tc.defaultLang); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("FallbackChain(%q, %q) = %v, want %v", tc.tag, tc.defaultLang, got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestParseAcceptLanguage tests parsing HTTP Accept-Language headers.
+func TestParseAcceptLanguage(t *testing.T) {
+	testCases := []struct {
+		header   string   // Accept-Language header
+		expected []string // Expected tags ordered by quality
+	}{
+		{header: "tr-TR,tr;q=0.9,en;q=0.8", expected: []string{"tr-TR", "tr", "en"}},
+		{header: "en;q=0.5, de", expected: []string{"de", "en"}},
+		{header: "fr;q=0, *;q=0.1, it;q=invalid, es", expected: []string{"es"}},
+		{header: "", expected: []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.header, func(t *testing.T) {
+			if got := ParseAcceptLanguage(tc.header); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("ParseAcceptLanguage(%q) = %v, want %v", tc.header, got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestMatchAcceptLanguage tests picking the best available language for an Accept-Language header.
+func TestMatchAcceptLanguage(t *testing.T) {
+	available := []string{"en", "tr", "pt-BR"}
+
+	testCases := []struct {
+		header   string // Accept-Language header
+		expected string // Expected language, empty if none matches
+	}{
+		{header: "tr-TR,tr;q=0.9,en;q=0.8", expected: "tr"},
+		{header: "pt_br", expected: "pt-BR"},
+		{header: "de;q=0.9,en-GB;q=0.8", expected: "en"},
+		{header: "de,fr", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.header, func(t *testing.T) {
+			got, ok := MatchAcceptLanguage(tc.header, available)
+			if got != tc.expected || ok != (tc.expected != "") {
+				t.Errorf("MatchAcceptLanguage(%q) = %q, %v, want %q", tc.header, got, ok, tc.expected)
+			}
+		})
+	}
+}
+
+// TestBundledLanguages tests listing the languages of the bundled locale files.
+func TestBundledLanguages(t *testing.T) {
+	if got := BundledLanguages(); !reflect.DeepEqual(got, []string{"en", "tr"}) {
+		t.Errorf("Expected bundled languages [en tr], got %v", got)
+	}
+}
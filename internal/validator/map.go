@@ -0,0 +1,127 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// wildcardKey matches every element of a slice or array, or every value of a map, in the keys of a rule map.
+const wildcardKey = "*"
+
+// ValidateMap validates dynamic data, such as JSON decoded into a map[string]interface{}, against a rule map
+// using the validation rules of the registry. The keys of the rule map are dotted paths into the data,
+// such as "user.email" or "items.*.sku", where "*" matches every element of a slice or every value of a map,
+// and the values are validation tags such as "required,email".
+//
+// Missing keys are validated like empty strings, so "required" reports them and "omitempty" skips them.
+// Error paths use the keys of the data with collection indices, e.g. "items[2].sku".
+// Validation failures are returned as ValidationErrors, like for structs.
+func (r *Registry) ValidateMap(data map[string]interface{}, rules map[string]string, opts Options) error {
+	return r.ValidateMapCtx(context.Background(), data, rules, opts)
+}
+
+// ValidateMapCtx validates dynamic data like ValidateMap, passing the context to the validation rules.
+func (r *Registry) ValidateMapCtx(ctx context.Context, data map[string]interface{}, rules map[string]string, opts Options) error {
+	top := reflect.ValueOf(data)
+
+	v, err := r.newValidation(ctx, opts, top)
+	if err != nil {
+		return err
+	}
+
+	// Apply the rules in the order of their keys, so that validation errors are reported in a deterministic order
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if v.cancelled() {
+			break
+		}
+		segments := strings.Split(key, ".")
+		field := v.state.varPlan(mapFieldName(segments), rules[key])
+		if field.err != nil {
+			return &TagError{Field: key, Tag: field.err.Tag, Reason: field.err.Reason}
+		}
+		v.validateMapPath(field, top, top, "", segments)
+	}
+
+	return v.result()
+}
+
+// validateMapPath resolves the remaining segments of a rule map key in a value and applies the rules
+// of the field to the values found. The parent is the map or struct containing the value.
+// Wildcard segments are expanded to every element of slices, arrays and maps.
+func (v *validation) validateMapPath(field *fieldPlan, parent, value reflect.Value, path string, segments []string) {
+	if v.cancelled() {
+		return
+	}
+
+	if len(segments) == 0 {
+		// Missing values are validated like empty strings
+		if !value.IsValid() {
+			value = reflect.ValueOf("")
+		}
+		ref := fieldRef{parent: parent, name: field.name, alias: field.alias(v.langs)}
+		v.validateElement(ref, path, value, field.rules)
+		return
+	}
+
+	segment, rest := segments[0], segments[1:]
+	value = indirect(value)
+
+	if segment == wildcardKey {
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				v.validateMapPath(field, value, value.Index(i), indexPath(path, i), rest)
+			}
+		case reflect.Map:
+			for _, key := range sortedMapKeys(value) {
+				v.validateMapPath(field, value, value.MapIndex(key), keyPath(path, key), rest)
+			}
+		}
+		return
+	}
+
+	elemPath := joinPath(path, segment)
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type().Key().Kind() == reflect.String {
+			elem := value.MapIndex(reflect.ValueOf(segment).Convert(value.Type().Key()))
+			v.validateMapPath(field, value, elem, elemPath, rest)
+			return
+		}
+	case reflect.Slice, reflect.Array:
+		if i, err := strconv.Atoi(segment); err == nil {
+			var elem reflect.Value
+			if i >= 0 && i < value.Len() {
+				elem = value.Index(i)
+			}
+			v.validateMapPath(field, value, elem, indexPath(path, i), rest)
+			return
+		}
+	case reflect.Struct:
+		v.validateMapPath(field, value, value.FieldByName(segment), elemPath, rest)
+		return
+	}
+
+	// The value doesn't hold the segment, so the remaining path is missing
+	v.validateMapPath(field, reflect.Value{}, reflect.Value{}, elemPath, rest)
+}
+
+// mapFieldName returns the field name used in error messages for a rule map key,
+// which is its last segment that is not a wildcard, e.g. "tags" for "tags.*".
+func mapFieldName(segments []string) string {
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] != wildcardKey {
+			return segments[i]
+		}
+	}
+	return defaultVarName
+}
@@ -0,0 +1,127 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestValidateMap tests validating dynamic data against a rule map.
+func TestValidateMap(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	data := map[string]interface{}{
+		"event": "order.created",
+		"user": map[string]interface{}{
+			"email": "invalid",
+			"name":  "John",
+		},
+		"items": []interface{}{
+			map[string]interface{}{"sku": "A-1", "quantity": 2},
+			map[string]interface{}{"quantity": 1},
+		},
+		"tags": map[string]interface{}{"env": "", "team": "core"},
+	}
+
+	testCases := []struct {
+		name   string            // Name of the test case
+		rules  map[string]string // Rule map
+		expect []string          // Expected "path:rule" of the failing values
+	}{
+		{
+			name:   "Valid",
+			rules:  map[string]string{"event": "required", "user.name": "required,min=2"},
+			expect: nil,
+		},
+		{
+			name:   "NestedKey",
+			rules:  map[string]string{"user.email": "required,email"},
+			expect: []string{"user.email:email"},
+		},
+		{
+			name:   "MissingKeys",
+			rules:  map[string]string{"source": "required", "user.phone": "omitempty,min=5", "account.id": "required"},
+			expect: []string{"account.id:required", "source:required"},
+		},
+		{
+			name:   "SliceWildcard",
+			rules:  map[string]string{"items.*.sku": "required", "items": "required"},
+			expect: []string{"items[1].sku:required"},
+		},
+		{
+			name:   "SliceIndex",
+			rules:  map[string]string{"items.0.sku": "required", "items.5.sku": "required"},
+			expect: []string{"items[5].sku:required"},
+		},
+		{
+			name:   "MapWildcard",
+			rules:  map[string]string{"tags.*": "required"},
+			expect: []string{"tags[env]:required"},
+		},
+		{
+			name:   "CrossField",
+			rules:  map[string]string{"user.name": "nefield=email", "user.phone": "required_without=email"},
+			expect: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.ValidateMap(data, tc.rules, Options{})
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Path+":"+fieldErr.Rule)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing values %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestValidateMapMessages tests the localized messages and field aliases of rule map errors.
+func TestValidateMapMessages(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	data := map[string]interface{}{"user": map[string]interface{}{"name": "J"}}
+	rules := map[string]string{"user.name": "min=2,tr=Ad", "user.email": "required"}
+
+	err := defaultRegistry.ValidateMap(data, rules, Options{Lang: "tr"})
+	expected := "email zorunludur;\nAd en az 2 karakter olmalıdır"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestValidateMapNonStrings tests that string rules reject values of other JSON types instead of panicking.
+func TestValidateMapNonStrings(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	data := map[string]interface{}{"number": 42.0, "bool": true, "null": nil, "list": []interface{}{"a"}}
+
+	for _, rule := range []string{"email", "date", "uppercase", "lowercase", "special"} {
+		for key := range data {
+			t.Run(rule+"/"+key, func(t *testing.T) {
+				err := defaultRegistry.ValidateMap(data, map[string]string{key: rule}, Options{})
+				var validationErrors ValidationErrors
+				if !errors.As(err, &validationErrors) || len(validationErrors) != 1 {
+					t.Fatalf("Expected a validation error, got '%v'", err)
+				}
+				if validationErrors[0].Rule != rule {
+					t.Errorf("Expected rule %s to fail, got %s", rule, validationErrors[0].Rule)
+				}
+			})
+		}
+	}
+}
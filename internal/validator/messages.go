@@ -0,0 +1,161 @@
+package validator
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// RegisterLocale registers error messages for a language.
+// The language is a BCP 47 tag such as "de" or "pt-BR", normalized with locales.NormalizeTag.
+// The messages are merged into the messages already registered for the language,
+// and override the bundled messages of the language with the same keys.
+// Keys that are not registered keep their bundled messages, so a single message can be replaced.
+func (r *Registry) RegisterLocale(lang string, messages map[string]string) {
+	lang = locales.NormalizeTag(lang)
+	r.updateLocales(func(registered map[string]locales.ErrorMessages) {
+		merged := make(locales.ErrorMessages, len(registered[lang])+len(messages))
+		for key, message := range registered[lang] {
+			merged[key] = message
+		}
+		for key, message := range messages {
+			merged[key] = message
+		}
+		registered[lang] = merged
+	})
+}
+
+// RegisterLocaleReader reads error messages for a language as a JSON object from a reader and registers them.
+// It returns an error if the messages cannot be read or parsed.
+func (r *Registry) RegisterLocaleReader(lang string, reader io.Reader) error {
+	messages, err := locales.ParseMessages(reader)
+	if err != nil {
+		return err
+	}
+	r.RegisterLocale(lang, messages)
+	return nil
+}
+
+// RegisterLocaleFS registers the error messages of every JSON file in the root directory of a file system.
+// The language of each file is taken from its name, e.g. "de.json" registers messages for "de".
+// It returns an error if a file cannot be read or parsed, in which case no messages are registered.
+func (r *Registry) RegisterLocaleFS(fsys fs.FS) error {
+	names, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return err
+	}
+
+	// Load all files before registering, so that a broken file doesn't leave a partial registration
+	loaded := make(map[string]locales.ErrorMessages, len(names))
+	for _, name := range names {
+		lang := strings.TrimSuffix(path.Base(name), ".json")
+		messages, err := locales.LoadMessagesFromFS(fsys, lang)
+		if err != nil {
+			return err
+		}
+		loaded[lang] = messages
+	}
+
+	for lang, messages := range loaded {
+		r.RegisterLocale(lang, messages)
+	}
+	return nil
+}
+
+// SetMessage overrides a single error message of a language, e.g. the "required" message for "en".
+func (r *Registry) SetMessage(lang, key, message string) {
+	r.RegisterLocale(lang, map[string]string{key: message})
+}
+
+// locale holds the resolved fallback chain and error messages of a requested language.
+type locale struct {
+	chain    []string              // chain holds the languages looked up, from the most to the least specific
+	messages locales.ErrorMessages // messages holds the messages of the chain, more specific languages taking precedence
+}
+
+// resolveLocale returns the fallback chain and error messages of a language, such as "pt-BR",
+// falling back to less specific languages, then to the default language and finally to English.
+// Messages are resolved per key, so a language lacking a message uses the message of the next language in the chain.
+// The messages are cached in the registry state by the languages of the chain that have messages,
+// so that requests for arbitrary languages, e.g. from Accept-Language headers, can't grow the cache without bound.
+// It returns an error if no language of the chain has bundled or registered messages.
+func (s *registryState) resolveLocale(lang, defaultLang string) (*locale, error) {
+	chain := locales.FallbackChain(lang, defaultLang)
+	available := make([]string, 0, len(chain))
+	for _, l := range chain {
+		if s.hasMessages(l) {
+			available = append(available, l)
+		}
+	}
+	if len(available) == 0 {
+		return nil, fmt.Errorf("no messages found for language %q", lang)
+	}
+
+	key := strings.Join(available, "|")
+	resolved, ok := s.resolved.Load(key)
+	if !ok {
+		// Layer the messages from the least to the most specific language
+		messages := make(locales.ErrorMessages)
+		for i := len(available) - 1; i >= 0; i-- {
+			s.mergeMessages(messages, available[i])
+		}
+		resolved, _ = s.resolved.LoadOrStore(key, &locale{chain: available, messages: messages})
+	}
+
+	// Keep the languages without messages in the chain, as field aliases may use them
+	cached := resolved.(*locale)
+	if len(available) == len(chain) {
+		return cached, nil
+	}
+	return &locale{chain: chain, messages: cached.messages}, nil
+}
+
+// hasMessages reports whether a language has bundled or registered messages.
+func (s *registryState) hasMessages(lang string) bool {
+	if _, ok := s.locales[lang]; ok {
+		return true
+	}
+	_, err := locales.LoadMessagesFromJSON(lang)
+	return err == nil
+}
+
+// mergeMessages merges the messages of a language into the given messages:
+// the bundled messages of the language, overridden by the messages registered in the registry.
+func (s *registryState) mergeMessages(messages locales.ErrorMessages, lang string) {
+	bundled, _ := locales.LoadMessagesFromJSON(lang)
+	for key, message := range bundled {
+		messages[key] = message
+	}
+	for key, message := range s.locales[lang] {
+		messages[key] = message
+	}
+}
+
+// AvailableLanguages returns the languages with bundled or registered messages, sorted alphabetically.
+func (r *Registry) AvailableLanguages() []string {
+	seen := make(map[string]bool)
+	for _, lang := range locales.BundledLanguages() {
+		seen[lang] = true
+	}
+	for lang := range r.state.Load().locales {
+		seen[lang] = true
+	}
+
+	langs := make([]string, 0, len(seen))
+	for lang := range seen {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// MatchAcceptLanguage picks the best available language for an HTTP Accept-Language header.
+// It returns false if none of the requested languages is available.
+func (r *Registry) MatchAcceptLanguage(header string) (string, bool) {
+	return locales.MatchAcceptLanguage(header, r.AvailableLanguages())
+}
@@ -0,0 +1,162 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// TestRegisterLocale tests registering messages for a language that is not bundled.
+func TestRegisterLocale(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterLocale("de", map[string]string{
+		"required":  "%s ist erforderlich",
+		"minLength": "%s muss mindestens %d Zeichen lang sein",
+	})
+
+	err := r.ValidateStruct(User{Username: "", Password: "weak"}, "de")
+	expected := "Username ist erforderlich;\nPassword muss mindestens 8 Zeichen lang sein"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestSetMessage tests overriding a single bundled message without affecting the others or other registries.
+func TestSetMessage(t *testing.T) {
+	r := NewRegistry()
+	r.SetMessage("en", "required", "Please fill in %s")
+
+	// The overridden message is used, the other bundled messages are kept
+	err := r.ValidateStruct(User{Username: "", Password: "weak"}, "en")
+	expected := "Please fill in Username;\nPassword must be at least 8 characters long"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+
+	// Other registries keep the bundled message
+	err = NewRegistry().ValidateStruct(User{Password: "securepassword"}, "en")
+	if err == nil || err.Error() != "Username is required" {
+		t.Errorf("Expected bundled message, got '%v'", err)
+	}
+}
+
+// TestRegisterLocaleReader tests registering messages read from a JSON reader.
+func TestRegisterLocaleReader(t *testing.T) {
+	r := NewRegistry()
+
+	// Register valid messages
+	if err := r.RegisterLocaleReader("de", strings.NewReader(`{"required": "%s ist erforderlich"}`)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	err := r.ValidateStruct(User{Password: "securepassword"}, "de")
+	if err == nil || err.Error() != "Username ist erforderlich" {
+		t.Errorf("Expected registered message, got '%v'", err)
+	}
+
+	// Register invalid JSON
+	if err := r.RegisterLocaleReader("ar", strings.NewReader(`{invalid`)); err == nil {
+		t.Errorf("Expected error for invalid JSON, got none")
+	}
+}
+
+// TestRegisterLocaleFS tests registering the messages of every JSON file in a file system.
+func TestRegisterLocaleFS(t *testing.T) {
+	r := NewRegistry()
+	fsys := fstest.MapFS{
+		"de.json":    {Data: []byte(`{"required": "%s ist erforderlich"}`)},
+		"ar.json":    {Data: []byte(`{"required": "%s مطلوب"}`)},
+		"README.txt": {Data: []byte(`ignored`)},
+	}
+	if err := r.RegisterLocaleFS(fsys); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for lang, expected := range map[string]string{"de": "Username ist erforderlich", "ar": "Username مطلوب"} {
+		err := r.ValidateStruct(User{Password: "securepassword"}, lang)
+		if err == nil || err.Error() != expected {
+			t.Errorf("Expected error '%s' for language %s, got '%v'", expected, lang, err)
+		}
+	}
+
+	// A broken file fails the registration
+	broken := fstest.MapFS{"fr.json": {Data: []byte(`[]`)}}
+	if err := r.RegisterLocaleFS(broken); err == nil {
+		t.Errorf("Expected error for broken file, got none")
+	}
+}
+
+// TestLocaleFallback tests falling back along the language chain, per message key.
+func TestLocaleFallback(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterLocale("pt", map[string]string{"required": "%s é obrigatório"})
+	r.RegisterLocale("pt_BR", map[string]string{"minLength": "%s deve ter pelo menos %d caracteres"})
+
+	testCases := []struct {
+		name   string  // Name of the test case
+		opts   Options // Validation options
+		expect string  // Expected error message
+	}{
+		{
+			name:   "RegionFallsBackToLanguagePerKey",
+			opts:   Options{Lang: "pt-BR"},
+			expect: "Username é obrigatório;\nPassword deve ter pelo menos 8 caracteres",
+		},
+		{
+			name:   "RegionFallsBackToBundledLanguage",
+			opts:   Options{Lang: "tr-TR"},
+			expect: "Username zorunludur;\nPassword en az 8 karakter olmalıdır",
+		},
+		{
+			name:   "UnknownFallsBackToDefault",
+			opts:   Options{Lang: "de", DefaultLang: "tr"},
+			expect: "Username zorunludur;\nPassword en az 8 karakter olmalıdır",
+		},
+		{
+			name:   "MissingKeyFallsBackToEnglish",
+			opts:   Options{Lang: "pt"},
+			expect: "Username é obrigatório;\nPassword must be at least 8 characters long",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := r.Validate(User{Password: "weak"}, tc.opts)
+			if err == nil || err.Error() != tc.expect {
+				t.Errorf("Expected error '%s', got '%v'", tc.expect, err)
+			}
+		})
+	}
+}
+
+// TestLocaleCacheBounded tests that resolving arbitrary unknown languages doesn't grow the locale cache.
+func TestLocaleCacheBounded(t *testing.T) {
+	r := NewRegistry()
+	for i := 0; i < 100; i++ {
+		if err := r.Validate(User{Password: "weak"}, Options{Lang: fmt.Sprintf("x-%d", i)}); err == nil {
+			t.Fatal("Expected validation error, got none")
+		}
+	}
+
+	entries := 0
+	r.state.Load().resolved.Range(func(key, value interface{}) bool {
+		entries++
+		return true
+	})
+	if entries != 1 {
+		t.Errorf("Expected 1 cached locale, got %d", entries)
+	}
+}
+
+// TestRegistryMatchAcceptLanguage tests matching an Accept-Language header against bundled and registered languages.
+func TestRegistryMatchAcceptLanguage(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterLocale("de", map[string]string{"required": "%s ist erforderlich"})
+
+	if lang, ok := r.MatchAcceptLanguage("fr;q=0.9,de-AT;q=0.8,en;q=0.5"); !ok || lang != "de" {
+		t.Errorf("Expected 'de', got '%s'", lang)
+	}
+	if lang, ok := r.MatchAcceptLanguage("tr-TR"); !ok || lang != "tr" {
+		t.Errorf("Expected 'tr', got '%s'", lang)
+	}
+}
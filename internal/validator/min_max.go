@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/abdullahkabakk/validator/internal/validator/locales"
 )
@@ -11,24 +12,26 @@ import (
 // validateMinLength validates if the length of a value is greater than or equal to the minimum length.
 // It parses the rule string to extract the minimum length requirement, then compares it with the length of the value.
 // If the length of the value is less than the minimum length, it returns an error.
+// Strings are measured in runes by default, or in the mode given after the length (e.g. "min=5 graphemes"),
+// and slices, arrays and maps by their number of items.
 // The fieldName parameter is used to customize the error message to include the name of the field being validated.
 // The rule parameter specifies the minimum length requirement.
 func validateMinLength(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
 	// Parse the rule to get the minimum length
-	minLength, err := parseRule(rule)
+	minLength, mode, err := parseLengthRule(rule)
 	if err != nil {
 		return err
 	}
 
 	// Get the length of the value
-	length, err := getValueLength(value)
+	length, items, err := measureLength(value, mode)
 	if err != nil {
 		return err
 	}
 
 	// Check if the length is less than the minimum length
 	if length < minLength {
-		return fmt.Errorf(messages["minLength"], fieldName, minLength)
+		return NewRuleError(messages, lengthKey("min", items), fieldName, locales.Param{Name: "min", Value: minLength})
 	}
 
 	return nil
@@ -37,45 +40,109 @@ func validateMinLength(value reflect.Value, messages locales.ErrorMessages, fiel
 // validateMaxLength validates if the length of a value is less than or equal to the maximum length.
 // It parses the rule string to extract the maximum length requirement, then compares it with the length of the value.
 // If the length of the value exceeds the maximum length, it returns an error.
+// Strings are measured in runes by default, or in the mode given after the length (e.g. "max=5 bytes"),
+// and slices, arrays and maps by their number of items.
 // The fieldName parameter is used to customize the error message to include the name of the field being validated.
 // The rule parameter specifies the maximum length requirement.
 func validateMaxLength(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
 	// Parse the rule to get the maximum length
-	maxLength, err := parseRule(rule)
+	maxLength, mode, err := parseLengthRule(rule)
 	if err != nil {
 		return err
 	}
 
 	// Get the length of the value
-	length, err := getValueLength(value)
+	length, items, err := measureLength(value, mode)
 	if err != nil {
 		return err
 	}
 
 	// Check if the length is greater than the maximum length
 	if length > maxLength {
-		return fmt.Errorf(messages["maxLength"], fieldName, maxLength)
+		return NewRuleError(messages, lengthKey("max", items), fieldName, locales.Param{Name: "max", Value: maxLength})
 	}
 
 	return nil
 }
 
-// getValueLength returns the length of a value based on its type.
-// It supports string, integer, floating-point, and unsigned integer types.
+// validateLength validates if the length of a value is exactly the given length, e.g. "len=11".
+// Strings are measured like for min and max, and slices, arrays and maps by their number of items.
+// The fieldName parameter is used to customize the error message to include the name of the field being validated.
+// The rule parameter specifies the exact length requirement.
+func validateLength(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
+	// Parse the rule to get the exact length
+	exactLength, mode, err := parseLengthRule(rule)
+	if err != nil {
+		return err
+	}
+
+	// Get the length of the value
+	length, items, err := measureLength(value, mode)
+	if err != nil {
+		return err
+	}
+
+	// Check if the length differs from the exact length
+	if length != exactLength {
+		return NewRuleError(messages, lengthKey("len", items), fieldName, locales.Param{Name: "len", Value: exactLength})
+	}
+
+	return nil
+}
+
+// lengthKey returns the message key of a length rule, e.g. "minLength" for strings and "minItems" for collections.
+func lengthKey(rule string, items bool) string {
+	if items {
+		return rule + "Items"
+	}
+	return rule + "Length"
+}
+
+// parseLengthRule extracts the length and the string length mode from a length rule, such as "min=5" or "max=10 bytes".
+func parseLengthRule(rule string) (int, lengthMode, error) {
+	_, param, ok := strings.Cut(rule, "=")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid rule format: %s", rule)
+	}
+
+	args := strings.Fields(param)
+	if len(args) == 0 || len(args) > 2 {
+		return 0, 0, fmt.Errorf("invalid rule format: %s", rule)
+	}
+
+	length, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	mode := lengthRunes
+	if len(args) == 2 {
+		if mode, ok = lengthModes[args[1]]; !ok {
+			return 0, 0, fmt.Errorf("invalid length mode: %s", args[1])
+		}
+	}
+	return length, mode, nil
+}
+
+// measureLength returns the length of a value based on its type, and whether it is a number of items.
+// Strings are measured in the given mode, slices, arrays and maps by their number of items.
+// For integers, the length is the integer itself, and for floating-point numbers the length of their decimal representation.
 // For other types, it returns an error indicating that the type is not supported for length validation.
-func getValueLength(value reflect.Value) (int, error) {
+func measureLength(value reflect.Value, mode lengthMode) (int, bool, error) {
 	// Check the type of the value and get its length
 	switch value.Kind() {
 	case reflect.String:
-		return len(value.String()), nil
+		return stringLength(value.String(), mode), false, nil
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return value.Len(), true, nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return int(value.Int()), nil
+		return int(value.Int()), false, nil
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return int(value.Uint()), nil
+		return int(value.Uint()), false, nil
 	case reflect.Float32, reflect.Float64:
 		str := strconv.FormatFloat(value.Float(), 'f', -1, 64)
-		return len(str), nil
+		return len(str), false, nil
 	default:
-		return 0, fmt.Errorf("unsupported type for length validation: %v", value.Kind())
+		return 0, false, fmt.Errorf("unsupported type for length validation: %v", value.Kind())
 	}
 }
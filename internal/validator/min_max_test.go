@@ -17,8 +17,14 @@ func TestValidateMinLength(t *testing.T) {
 		{"hello", "min=7", false},    // value length is less than minLength
 		{"world", "min=5", true},     // value length is equal to minLength
 		{"greetings", "min=5", true}, // value length is greater than minLength
+		{"Çağrı", "min=5", true},     // value length is counted in runes
+		{"Çağrı", "min=6 bytes", true},
+		{[]int{1, 2, 3}, "min=5", false}, // slice has fewer items than minLength
+		{[]int{1, 2, 3}, "min=3", true},
 		// unsupported type
-		{[]int{1, 2, 3}, "min=5", false},
+		{true, "min=5", false},
+		// invalid length mode
+		{"hello", "min=5 words", false},
 		// invalid rule
 		{"hello", "invalid", false},
 	}
@@ -50,8 +56,12 @@ func TestValidateMaxLength(t *testing.T) {
 		{"hello", "max=5", true},      // value length is less than maxLength
 		{"world", "max=5", true},      // value length is equal to maxLength
 		{"greetings", "max=5", false}, // value length is greater than maxLength
+		{"Çağrı", "max=5", true},      // value length is counted in runes
+		{"Çağrı", "max=5 bytes", false},
+		{[]int{1, 2, 3}, "max=5", true},                  // slice has fewer items than maxLength
+		{map[string]int{"a": 1, "b": 2}, "max=1", false}, // map has more items than maxLength
 		// unsupported type
-		{[]int{1, 2, 3}, "max=5", false},
+		{true, "max=5", false},
 		// invalid rule
 		{"hello", "invalid", false},
 	}
@@ -73,34 +83,111 @@ func TestValidateMaxLength(t *testing.T) {
 	}
 }
 
-// TestGetValueLength tests the getValueLength function.
-func TestGetValueLength(t *testing.T) {
+// TestMeasureLength tests the measureLength function.
+func TestMeasureLength(t *testing.T) {
 	tests := []struct {
 		name     string      // Test case name
 		value    interface{} // Input value
+		mode     lengthMode  // Mode measuring strings
 		expected int         // Expected length
+		items    bool        // Whether the length is a number of items
 		wantErr  bool        // Whether an error is expected
 	}{
-		{name: "String", value: "hello", expected: 5, wantErr: false},
-		{name: "Int", value: 12345, expected: 12345, wantErr: false},
-		{name: "Uint", value: uint(54321), expected: 54321, wantErr: false},
-		{name: "Float32", value: float32(3.14159), expected: 17, wantErr: false},
-		{name: "Float64", value: 3.14159, expected: 7, wantErr: false},
-		{name: "Unsupported", value: []int{1, 2, 3}, expected: 0, wantErr: true},
+		{name: "String", value: "hello", expected: 5},
+		{name: "Int", value: 12345, expected: 12345},
+		{name: "Uint", value: uint(54321), expected: 54321},
+		{name: "Float32", value: float32(3.14159), expected: 17},
+		{name: "Float64", value: 3.14159, expected: 7},
+		{name: "Runes", value: "Çağrı", expected: 5},
+		{name: "Bytes", value: "Çağrı", mode: lengthBytes, expected: 8},
+		{name: "Graphemes", value: "👍🏽", mode: lengthGraphemes, expected: 1},
+		{name: "Slice", value: []int{1, 2, 3}, expected: 3, items: true},
+		{name: "Array", value: [2]string{}, expected: 2, items: true},
+		{name: "Map", value: map[string]int{"a": 1}, expected: 1, items: true},
+		{name: "Unsupported", value: true, wantErr: true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			value := reflect.ValueOf(tt.value)
-			got, err := getValueLength(value)
+			got, items, err := measureLength(value, tt.mode)
 			// Check if error matches the expectation
 			if (err != nil) != tt.wantErr {
-				t.Errorf("getValueLength() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("measureLength() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			// Check if length matches the expectation
-			if got != tt.expected {
-				t.Errorf("getValueLength() = %v, want %v", got, tt.expected)
+			if got != tt.expected || items != tt.items {
+				t.Errorf("measureLength() = %v, %v, want %v, %v", got, items, tt.expected, tt.items)
+			}
+		})
+	}
+}
+
+// TestValidateLength tests the validateLength function and the messages of length rules.
+func TestValidateLength(t *testing.T) {
+	tests := []struct {
+		name     string      // Test case name
+		value    interface{} // Input value
+		rule     string      // Length rule
+		expected string      // Expected error message, empty if no error is expected
+	}{
+		{name: "ExactString", value: "Çağrı", rule: "len=5"},
+		{name: "ShorterString", value: "abc", rule: "len=5", expected: "field must be exactly 5 characters long"},
+		{name: "ExactItems", value: []string{"a", "b"}, rule: "len=2"},
+		{name: "MoreItems", value: []string{"a", "b", "c"}, rule: "len=2", expected: "field must contain exactly 2 items"},
+		{name: "Graphemes", value: "👍🏽🇹🇷", rule: "len=2 graphemes"},
+	}
+
+	messages := locales.ErrorMessages{
+		"lenLength": "{field} must be exactly {len} characters long",
+		"lenItems":  "{field} must contain exactly {len} items",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLength(reflect.ValueOf(tt.value), messages, "field", tt.rule)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("Expected error '%s', got '%v'", tt.expected, err)
+			}
+		})
+	}
+}
+
+// TestStringLength tests measuring strings in runes, bytes and grapheme clusters.
+func TestStringLength(t *testing.T) {
+	tests := []struct {
+		value     string // Input string
+		runes     int    // Expected number of runes
+		bytes     int    // Expected number of bytes
+		graphemes int    // Expected number of grapheme clusters
+	}{
+		{value: "hello", runes: 5, bytes: 5, graphemes: 5},
+		{value: "Çağrı", runes: 5, bytes: 8, graphemes: 5},
+		{value: "e\u0301", runes: 2, bytes: 3, graphemes: 1},            // e with a combining acute accent
+		{value: "👍🏽", runes: 2, bytes: 8, graphemes: 1},                 // thumbs up with a skin tone modifier
+		{value: "👨‍👩‍👧", runes: 5, bytes: 18, graphemes: 1},             // family joined by zero-width joiners
+		{value: "🇹🇷🇺🇸🇩", runes: 5, bytes: 20, graphemes: 3},             // two flags and a lone regional indicator
+		{value: "a\r\nb", runes: 4, bytes: 4, graphemes: 3},             // CRLF counts as one character
+		{value: "\u1100\u1161\u11a8", runes: 3, bytes: 9, graphemes: 1}, // Hangul syllable from jamo
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := stringLength(tt.value, lengthRunes); got != tt.runes {
+				t.Errorf("runes = %d, want %d", got, tt.runes)
+			}
+			if got := stringLength(tt.value, lengthBytes); got != tt.bytes {
+				t.Errorf("bytes = %d, want %d", got, tt.bytes)
+			}
+			if got := stringLength(tt.value, lengthGraphemes); got != tt.graphemes {
+				t.Errorf("graphemes = %d, want %d", got, tt.graphemes)
 			}
 		})
 	}
@@ -0,0 +1,175 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// numberPrec is the precision in bits of the numbers compared by the numeric comparison rules.
+// It is large enough to hold any int64 and uint64 exactly and decimal parameters with negligible rounding.
+const numberPrec = 256
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})   // bigIntType is the reflect.Type of big.Int
+	bigFloatType = reflect.TypeOf(big.Float{}) // bigFloatType is the reflect.Type of big.Float
+)
+
+// validateGt validates if a number is greater than the rule parameter, e.g. "gt=0".
+func validateGt(rc *RuleContext) error {
+	return compareNumber(rc, "gtValue", func(c int) bool { return c > 0 })
+}
+
+// validateGte validates if a number is greater than or equal to the rule parameter, e.g. "gte=18".
+func validateGte(rc *RuleContext) error {
+	return compareNumber(rc, "gteValue", func(c int) bool { return c >= 0 })
+}
+
+// validateLt validates if a number is less than the rule parameter, e.g. "lt=100".
+func validateLt(rc *RuleContext) error {
+	return compareNumber(rc, "ltValue", func(c int) bool { return c < 0 })
+}
+
+// validateLte validates if a number is less than or equal to the rule parameter, e.g. "lte=99.99".
+func validateLte(rc *RuleContext) error {
+	return compareNumber(rc, "lteValue", func(c int) bool { return c <= 0 })
+}
+
+// validateEq validates if a value is equal to the rule parameter, e.g. "eq=10".
+// Numbers and numeric strings are compared numerically, so "eq=10" accepts 10.0; other strings are compared as is.
+func validateEq(rc *RuleContext) error {
+	return compareNumber(rc, "eqValue", func(c int) bool { return c == 0 })
+}
+
+// validateNe validates if a value is not equal to the rule parameter, e.g. "ne=0".
+// Numbers and numeric strings are compared numerically; other strings are compared as is.
+func validateNe(rc *RuleContext) error {
+	return compareNumber(rc, "neValue", func(c int) bool { return c != 0 })
+}
+
+// validateBetween validates if a number is between two bounds, inclusive, e.g. "between=1 10".
+func validateBetween(rc *RuleContext) error {
+	args := ruleArgs(rc)
+	if len(args) != 2 {
+		return fmt.Errorf("invalid parameter for %s: %s", rc.Rule, rc.Param)
+	}
+
+	number, ok := toBigFloat(rc.Value)
+	if !ok {
+		return fmt.Errorf("unsupported type for %s: %v", rc.Rule, rc.Value.Kind())
+	}
+	low, errLow := parseNumber(args[0], rc.Value)
+	high, errHigh := parseNumber(args[1], rc.Value)
+	if errLow != nil || errHigh != nil || low.Cmp(high) > 0 {
+		return fmt.Errorf("invalid parameter for %s: %s", rc.Rule, rc.Param)
+	}
+
+	if number.Cmp(low) < 0 || number.Cmp(high) > 0 {
+		return NewRuleError(rc.Messages, "between", rc.Field,
+			locales.Param{Name: "min", Value: args[0]}, locales.Param{Name: "max", Value: args[1]})
+	}
+	return nil
+}
+
+// compareNumber compares the value of the rule context with the number given as rule parameter,
+// and returns the error message with the given key if the comparison result doesn't satisfy the check.
+// For equality checks, strings that are not numbers are compared with the parameter as is.
+// It returns an error if the value is not a number or the parameter is not a valid number.
+func compareNumber(rc *RuleContext, key string, check func(c int) bool) error {
+	var c int
+	number, ok := toBigFloat(rc.Value)
+	switch {
+	case ok:
+		limit, err := parseNumber(rc.Param, rc.Value)
+		if err != nil {
+			return fmt.Errorf("invalid parameter for %s: %s", rc.Rule, rc.Param)
+		}
+		c = number.Cmp(limit)
+	case (key == "eqValue" || key == "neValue") && indirect(rc.Value).Kind() == reflect.String:
+		c = strings.Compare(indirect(rc.Value).String(), rc.Param)
+	default:
+		return fmt.Errorf("unsupported type for %s: %v", rc.Rule, rc.Value.Kind())
+	}
+
+	if !check(c) {
+		return NewRuleError(rc.Messages, key, rc.Field, locales.Param{Name: "value", Value: rc.Param})
+	}
+	return nil
+}
+
+// toBigFloat converts a number to a big.Float that represents it exactly.
+// Integers, unsigned integers, floating-point numbers, big.Int and big.Float values and pointers to them are supported,
+// as well as strings holding a finite decimal number, such as json.Number values.
+// Nil pointers to big numbers are zero, as nil pointers to other numbers are validated as their zero value.
+// It returns false for other values, NaN and other nil pointers.
+func toBigFloat(value reflect.Value) (*big.Float, bool) {
+	if isNilBigNumber(value) {
+		return new(big.Float).SetPrec(numberPrec), true
+	}
+	value = indirect(value)
+	if !value.IsValid() {
+		return nil, false
+	}
+
+	switch {
+	case isInt(value):
+		return new(big.Float).SetPrec(numberPrec).SetInt64(value.Int()), true
+	case isUint(value):
+		return new(big.Float).SetPrec(numberPrec).SetUint64(value.Uint()), true
+	case value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64:
+		if math.IsNaN(value.Float()) {
+			return nil, false
+		}
+		return new(big.Float).SetPrec(numberPrec).SetFloat64(value.Float()), true
+	case value.Kind() == reflect.String:
+		// Reject "Inf" and "-Inf", which are not numbers in text input
+		number, ok := new(big.Float).SetPrec(numberPrec).SetString(strings.TrimSpace(value.String()))
+		return number, ok && !number.IsInf()
+	case value.Type() == bigIntType && value.CanAddr():
+		return new(big.Float).SetPrec(numberPrec).SetInt(value.Addr().Interface().(*big.Int)), true
+	case value.Type() == bigFloatType && value.CanAddr():
+		return new(big.Float).SetPrec(numberPrec).Set(value.Addr().Interface().(*big.Float)), true
+	case value.Type() == bigIntType || value.Type() == bigFloatType:
+		// Copy unaddressable big numbers to take their address
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		return toBigFloat(ptr)
+	}
+	return nil, false
+}
+
+// isNilBigNumber reports whether a value is a nil pointer to a big.Int or a big.Float.
+func isNilBigNumber(value reflect.Value) bool {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return value.Type().Elem() == bigIntType || value.Type().Elem() == bigFloatType
+		}
+		value = value.Elem()
+	}
+	return false
+}
+
+// parseNumber parses a rule parameter as a number to compare a value with.
+// For floating-point values, the parameter is rounded to the precision of the value, so that "lte=0.1"
+// accepts a float64 holding 0.1, which is slightly greater than the decimal 0.1.
+func parseNumber(param string, value reflect.Value) (*big.Float, error) {
+	switch indirect(value).Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(param, indirect(value).Type().Bits())
+		if err != nil || math.IsNaN(f) {
+			return nil, fmt.Errorf("invalid number: %s", param)
+		}
+		return new(big.Float).SetPrec(numberPrec).SetFloat64(f), nil
+	}
+
+	number, ok := new(big.Float).SetPrec(numberPrec).SetString(param)
+	if !ok {
+		return nil, fmt.Errorf("invalid number: %s", param)
+	}
+	return number, nil
+}
@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// TestNumericRules tests comparing numbers of various types with rule parameters.
+func TestNumericRules(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	price := 9.99
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		expected string      // Expected error message, empty if validation passes
+	}{
+		{name: "FloatGt", input: 123.45, tag: "gt=10"},
+		{name: "FloatNotGt", input: 0.5, tag: "gt=10", expected: "Value must be greater than 10"},
+		{name: "FloatLteExact", input: 0.1, tag: "lte=0.1"},
+		{name: "Float32LteExact", input: float32(0.1), tag: "lte=0.1"},
+		{name: "FloatPointer", input: &price, tag: "lt=10"},
+		{name: "IntGte", input: 18, tag: "gte=18"},
+		{name: "IntNotGte", input: 17, tag: "gte=18", expected: "Value must be greater than or equal to 18"},
+		{name: "IntFractionalLimit", input: 5, tag: "lt=5.5"},
+		{name: "UintMax", input: uint64(math.MaxUint64), tag: "gt=9223372036854775807"},
+		{name: "UintMaxNotLt", input: uint64(math.MaxUint64), tag: "lt=18446744073709551615", expected: "Value must be less than 18446744073709551615"},
+		{name: "NegativeInt", input: int8(-5), tag: "lt=0"},
+		{name: "Eq", input: 10.0, tag: "eq=10"},
+		{name: "NotEq", input: 11, tag: "eq=10", expected: "Value must be equal to 10"},
+		{name: "Ne", input: 0, tag: "ne=0", expected: "Value must not be equal to 0"},
+		{name: "EqString", input: "yes", tag: "eq=yes"},
+		{name: "NeString", input: "no", tag: "ne=yes"},
+		{name: "NumericString", input: "12.5", tag: "gt=12"},
+		{name: "JSONNumber", input: json.Number("1e3"), tag: "lte=1000"},
+		{name: "BigInt", input: new(big.Int).Lsh(big.NewInt(1), 100), tag: "gt=18446744073709551615"},
+		{name: "BigIntValue", input: *big.NewInt(-3), tag: "lt=0"},
+		{name: "BigFloat", input: big.NewFloat(2.5), tag: "between=2 3"},
+		{name: "NilBigInt", input: (*big.Int)(nil), tag: "gt=0", expected: "Value must be greater than 0"},
+		{name: "NilBigFloat", input: (*big.Float)(nil), tag: "between=-1 1"},
+		{name: "Between", input: 5, tag: "between=1 10"},
+		{name: "BetweenBounds", input: 10, tag: "between=1 10"},
+		{name: "NotBetween", input: 10.5, tag: "between=1 10", expected: "Value must be between 1 and 10"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.ValidateVar("", tc.input, tc.tag, Options{})
+			if tc.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestNumericRuleErrors tests that unsupported values and invalid parameters are reported.
+func TestNumericRuleErrors(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		expected string      // Expected error message
+	}{
+		{name: "NonNumericString", input: "abc", tag: "gt=1", expected: "unsupported type for gt: string"},
+		{name: "Bool", input: true, tag: "eq=1", expected: "unsupported type for eq: bool"},
+		{name: "NaN", input: math.NaN(), tag: "lt=1", expected: "unsupported type for lt: float64"},
+		{name: "InfString", input: "Inf", tag: "gt=0", expected: "unsupported type for gt: string"},
+		{name: "NegativeInfString", input: "-inf", tag: "lt=0", expected: "unsupported type for lt: string"},
+		{name: "InvalidParameter", input: 1, tag: "gt=one", expected: "invalid parameter for gt: one"},
+		{name: "BetweenOneBound", input: 1, tag: "between=1", expected: "invalid parameter for between: 1"},
+		{name: "BetweenReversed", input: 1, tag: "between=10 1", expected: "invalid parameter for between: 10 1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.ValidateVar("", tc.input, tc.tag, Options{})
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestNumericRulesNotAliases tests that rules named like languages, such as "lt" and "ne", are not taken as aliases.
+func TestNumericRulesNotAliases(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Person struct {
+		Age   int `validate:"lt=100"`
+		Count int `validate:"ne=0"`
+	}
+
+	err := defaultRegistry.Validate(Person{Age: 100}, Options{Lang: "lt-LT"})
+	expected := "Age must be less than 100;\nCount must not be equal to 0"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+
+	err = defaultRegistry.Validate(Person{Age: 1}, Options{Lang: "ne"})
+	if err == nil || err.Error() != "Count must not be equal to 0" {
+		t.Errorf("Expected error 'Count must not be equal to 0', got '%v'", err)
+	}
+}
+
+// TestNilBigNumberFields tests that nil big number fields are validated as zero with localized messages.
+func TestNilBigNumberFields(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Account struct {
+		Balance *big.Int   `validate:"gt=0"`
+		Rate    *big.Float `validate:"gte=0"`
+	}
+
+	err := ValidateStruct(Account{}, "tr")
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 1 {
+		t.Fatalf("Expected 1 validation error, got '%v'", err)
+	}
+	if expected := "Balance, 0 değerinden büyük olmalıdır"; validationErrors[0].Message != expected {
+		t.Errorf("Expected message '%s', got '%s'", expected, validationErrors[0].Message)
+	}
+}
@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fieldFilter selects the fields validated by a partial validation.
+// Fields are identified by their dotted paths with collection indices removed, e.g. "Items.SKU" for "Items[3].SKU",
+// and selecting or excluding a field also selects or excludes the fields nested in it.
+type fieldFilter struct {
+	only   map[string]bool // only holds the paths of the fields to validate, nil to validate all fields
+	except map[string]bool // except holds the paths of the fields to skip
+}
+
+// newFieldFilter creates a field filter validating only the given fields, if any, and skipping the excepted fields.
+// It returns nil if neither fields nor excepted fields are given, so that all fields are validated.
+func newFieldFilter(fields, except []string) *fieldFilter {
+	if len(fields) == 0 && len(except) == 0 {
+		return nil
+	}
+
+	f := &fieldFilter{except: pathSet(except)}
+	if len(fields) > 0 {
+		f.only = pathSet(fields)
+	}
+	return f
+}
+
+// checkFieldPaths checks that the given field paths exist in a struct type.
+// It returns an error naming the first path that doesn't.
+func checkFieldPaths(typ reflect.Type, paths ...[]string) error {
+	for _, list := range paths {
+		for _, path := range list {
+			if !hasFieldPath(typ, stripIndices(path)) {
+				return fmt.Errorf("unknown field %q", path)
+			}
+		}
+	}
+	return nil
+}
+
+// hasFieldPath reports whether a dotted path with collection indices removed, such as "Items.SKU", names a field
+// of a struct type. Pointers and collections along the path are followed to their elements, and fields of embedded
+// structs are named without the embedded struct, as in validation errors.
+func hasFieldPath(typ reflect.Type, path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return false
+		}
+		field, ok := typ.FieldByName(name)
+		if !ok || field.Anonymous {
+			return false
+		}
+		typ = field.Type
+	}
+	return true
+}
+
+// pathSet returns the set of the given paths with collection indices removed.
+func pathSet(paths []string) map[string]bool {
+	set := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		set[stripIndices(path)] = true
+	}
+	return set
+}
+
+// match reports whether the rules of the field at the given path are applied,
+// and whether the fields nested in it may be selected, so that it must be walked.
+func (f *fieldFilter) match(path string) (validate, walk bool) {
+	if f == nil {
+		return true, true
+	}
+
+	path = stripIndices(path)
+	if containsPathOrParent(f.except, path) {
+		return false, false
+	}
+	if f.only == nil || containsPathOrParent(f.only, path) {
+		return true, true
+	}
+
+	// Walk the field if one of its nested fields is selected
+	prefix := path + "."
+	if path == "" {
+		prefix = ""
+	}
+	for selected := range f.only {
+		if strings.HasPrefix(selected, prefix) {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// includes reports whether the struct at the given path is validated as a whole,
+// in which case its struct-level rules are applied.
+func (f *fieldFilter) includes(path string) bool {
+	validate, _ := f.match(path)
+	return validate
+}
+
+// containsPathOrParent reports whether a set contains a path or one of its parent paths.
+func containsPathOrParent(set map[string]bool, path string) bool {
+	for {
+		if set[path] {
+			return true
+		}
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			return false
+		}
+		path = path[:i]
+	}
+}
+
+// stripIndices removes the collection indices of a path, e.g. "Items[3].SKU" becomes "Items.SKU".
+func stripIndices(path string) string {
+	if !strings.Contains(path, "[") {
+		return path
+	}
+
+	var b strings.Builder
+	depth := 0
+	for _, c := range path {
+		switch {
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// walkElements validates the struct elements of a collection whose own rules are not applied,
+// so that the selected fields nested in its elements are validated.
+func (v *validation) walkElements(path string, value reflect.Value) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len() && !v.cancelled(); i++ {
+			v.walkElement(indexPath(path, i), value.Index(i))
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(value) {
+			if v.cancelled() {
+				return
+			}
+			v.walkElement(keyPath(path, key), value.MapIndex(key))
+		}
+	}
+}
+
+// walkElement validates a collection element if it is a struct.
+func (v *validation) walkElement(path string, elem reflect.Value) {
+	if elem.Kind() == reflect.Interface && !elem.IsNil() {
+		elem = elem.Elem()
+	}
+	if nested, ok := structValue(elem); ok {
+		v.validateStruct(nested, path, false)
+	}
+}
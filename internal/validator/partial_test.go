@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type PatchItem struct {
+	SKU      string `validate:"required"`
+	Quantity int    `validate:"required"`
+}
+
+type PatchAddress struct {
+	Street string `validate:"required"`
+	City   string `validate:"required"`
+}
+
+type PatchUser struct {
+	Name    string `validate:"required"`
+	Email   string `validate:"required,email"`
+	Address PatchAddress
+	Items   []PatchItem `validate:"required,dive"`
+}
+
+// TestPartialValidation tests validating only selected fields or skipping excepted fields.
+func TestPartialValidation(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	input := PatchUser{Email: "invalid", Items: []PatchItem{{SKU: "A"}, {Quantity: 1}}}
+
+	testCases := []struct {
+		name   string   // Name of the test case
+		fields []string // Fields to validate
+		except []string // Fields to skip
+		expect []string // Expected paths of the failing fields
+	}{
+		{
+			name:   "AllFields",
+			expect: []string{"Name", "Email", "Address.Street", "Address.City", "Items[0].Quantity", "Items[1].SKU"},
+		},
+		{
+			name:   "TopLevelField",
+			fields: []string{"Email"},
+			expect: []string{"Email"},
+		},
+		{
+			name:   "NestedField",
+			fields: []string{"Name", "Address.City"},
+			expect: []string{"Name", "Address.City"},
+		},
+		{
+			name:   "NestedStruct",
+			fields: []string{"Address"},
+			expect: []string{"Address.Street", "Address.City"},
+		},
+		{
+			name:   "CollectionElementField",
+			fields: []string{"Items[0].SKU"},
+			expect: []string{"Items[1].SKU"},
+		},
+		{
+			name:   "Except",
+			except: []string{"Email", "Address.Street", "Items"},
+			expect: []string{"Name", "Address.City"},
+		},
+		{
+			name:   "FieldsAndExcept",
+			fields: []string{"Address"},
+			except: []string{"Address.Street"},
+			expect: []string{"Address.City"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.Validate(input, Options{Fields: tc.fields, Except: tc.except})
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Path)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing paths %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestPartialValidationUnknownFields tests that selecting or excepting a field that doesn't exist is an error.
+func TestPartialValidationUnknownFields(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name     string   // Name of the test case
+		fields   []string // Fields to validate
+		except   []string // Fields to skip
+		expected string   // Expected error message
+	}{
+		{name: "Misspelled", fields: []string{"Emial"}, expected: `unknown field "Emial"`},
+		{name: "Nested", fields: []string{"Name", "Address.Zip"}, expected: `unknown field "Address.Zip"`},
+		{name: "CollectionElement", fields: []string{"Items[0].Price"}, expected: `unknown field "Items[0].Price"`},
+		{name: "BeyondValue", fields: []string{"Name.First"}, expected: `unknown field "Name.First"`},
+		{name: "Except", except: []string{"Adress"}, expected: `unknown field "Adress"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.Validate(PatchUser{}, Options{Fields: tc.fields, Except: tc.except})
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestStripIndices tests removing collection indices from paths.
+func TestStripIndices(t *testing.T) {
+	testCases := map[string]string{
+		"Email":                   "Email",
+		"Items[3].SKU":            "Items.SKU",
+		"Tags[color]":             "Tags",
+		"Matrix[1][2].Value":      "Matrix.Value",
+		"Groups[a].Members[0].ID": "Groups.Members.ID",
+	}
+
+	for path, expected := range testCases {
+		if got := stripIndices(path); got != expected {
+			t.Errorf("stripIndices(%q) = %q, want %q", path, got, expected)
+		}
+	}
+}
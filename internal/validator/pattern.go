@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// maxPatternInput is the maximum length in bytes of a string matched against a regular expression.
+// Go regular expressions run in linear time, but matching unbounded user input still costs unbounded CPU,
+// so longer strings fail validation without being matched.
+const maxPatternInput = 4096
+
+const (
+	regexpTag  = "regexp"  // regexpTag matches a value against the regular expression given as parameter
+	patternTag = "pattern" // patternTag matches a value against a regular expression registered with RegisterPattern
+)
+
+// RegisterPattern compiles a regular expression and registers it under a name, to be used as "pattern=name".
+// Registering a pattern with the name of an existing pattern overrides it.
+// It returns an error if the regular expression cannot be compiled, in which case nothing is registered.
+func (r *Registry) RegisterPattern(name, pattern string) error {
+	if name == "" {
+		return fmt.Errorf("missing pattern name")
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern %s: %w", name, err)
+	}
+
+	r.updatePatterns(func(patterns map[string]*regexp.Regexp) {
+		patterns[name] = compiled
+	})
+	return nil
+}
+
+// validatePatternRule is the built-in "regexp" and "pattern" rule. Plans replace it with a rule bound to the
+// compiled regular expression, see compilePatternRule, so it only runs for a rule context built outside a plan,
+// in which case it compiles the regular expression on every call and knows no registered pattern.
+func validatePatternRule(rc *RuleContext) error {
+	validateFunc, err := compilePatternRule(tagItem{name: rc.Rule, param: rc.Param}, nil)
+	if err != nil {
+		return err
+	}
+	return validateFunc(rc)
+}
+
+// isBuiltinPatternRule reports whether a rule is the built-in regexp or pattern rule registered under its name,
+// which is compiled with its parameter. A rule registered under the same name overrides it like any other rule.
+func isBuiltinPatternRule(name string, validateFunc FieldRule) bool {
+	return (name == regexpTag || name == patternTag) &&
+		reflect.ValueOf(validateFunc).Pointer() == reflect.ValueOf(validatePatternRule).Pointer()
+}
+
+// compilePatternRule compiles a regular expression rule into a validation function bound to its regular expression,
+// so that it is compiled once per plan: "regexp='^[A-Z]{3}-\d{4}$'" compiles its parameter,
+// and "pattern=sku" looks up the pattern registered under the name.
+// It returns an error if the regular expression is invalid or the pattern is not registered.
+func compilePatternRule(item tagItem, patterns map[string]*regexp.Regexp) (FieldRule, error) {
+	if item.param == "" {
+		return nil, fmt.Errorf("missing parameter for %s", item.name)
+	}
+
+	var compiled *regexp.Regexp
+	if item.name == regexpTag {
+		var err error
+		if compiled, err = regexp.Compile(item.param); err != nil {
+			return nil, fmt.Errorf("invalid parameter for %s: %v", item.name, err)
+		}
+	} else {
+		var ok bool
+		if compiled, ok = patterns[item.param]; !ok {
+			return nil, fmt.Errorf("unknown pattern %s", item.param)
+		}
+	}
+
+	return func(rc *RuleContext) error {
+		return matchPattern(rc, compiled)
+	}, nil
+}
+
+// matchPattern matches the string value of the rule context against a regular expression.
+// Values that are not strings, such as numbers decoded from JSON, and strings longer than maxPatternInput
+// fail without being matched.
+func matchPattern(rc *RuleContext, compiled *regexp.Regexp) error {
+	value := indirect(rc.Value)
+	if !value.IsValid() || value.Kind() != reflect.String {
+		return NewRuleError(rc.Messages, "invalidPattern", rc.Field)
+	}
+
+	s := value.String()
+	if len(s) > maxPatternInput {
+		return NewRuleError(rc.Messages, "patternTooLong", rc.Field, locales.Param{Name: "max", Value: maxPatternInput})
+	}
+	if !compiled.MatchString(s) {
+		return NewRuleError(rc.Messages, "invalidPattern", rc.Field)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestPatternRules tests matching strings against regular expressions given in the tag or registered by name.
+func TestPatternRules(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.RegisterPattern("sku", `^[A-Z]{3}-\d{4}$`); err != nil {
+		t.Fatalf("Expected no error, got '%v'", err)
+	}
+
+	code := "ABC-1234"
+	long := strings.Repeat("a", maxPatternInput+1)
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		expected string      // Expected error message, empty if validation passes
+	}{
+		{name: "Regexp", input: "ABC-1234", tag: `regexp='^[A-Z]{3}-\d{4}$'`},
+		{name: "NotRegexp", input: "abc-1234", tag: `regexp='^[A-Z]{3}-\d{4}$'`, expected: "Value has an invalid format"},
+		{name: "RegexpComma", input: "aa", tag: `regexp='^a{1,3}$'`},
+		{name: "RegexpPointer", input: &code, tag: `regexp='^[A-Z]{3}-\d{4}$'`},
+		{name: "Pattern", input: "XYZ-0001", tag: "pattern=sku"},
+		{name: "NotPattern", input: "XYZ-01", tag: "pattern=sku", expected: "Value has an invalid format"},
+		{name: "RegexpTooLong", input: long, tag: "regexp=^a+$", expected: "Value must be at most 4096 bytes long to be checked"},
+		{name: "PatternTooLong", input: long, tag: "pattern=sku", expected: "Value must be at most 4096 bytes long to be checked"},
+		{name: "MaxLength", input: long[1:], tag: "regexp=^a+$"},
+		{name: "NotString", input: 42.0, tag: "regexp=^42$", expected: "Value has an invalid format"},
+		{name: "Grouped", input: "abc", tag: "regexp='^[0-9]+$'@create"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := registry.ValidateVar("", tc.input, tc.tag, Options{})
+			if tc.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestPatternTagErrors tests that invalid regular expressions and unknown patterns are reported as malformed tags.
+func TestPatternTagErrors(t *testing.T) {
+	registry := NewRegistry()
+
+	testCases := []struct {
+		name     string // Name of the test case
+		tag      string // Validation tag
+		expected string // Expected reason of the tag error
+	}{
+		{name: "InvalidRegexp", tag: "regexp='a('", expected: "invalid parameter for regexp: error parsing regexp: missing closing ): `a(`"},
+		{name: "MissingRegexp", tag: "regexp=''", expected: "missing parameter for regexp"},
+		{name: "MissingPattern", tag: "required,pattern", expected: "missing parameter for pattern"},
+		{name: "UnknownPattern", tag: "pattern=sku", expected: "unknown pattern sku"},
+		{name: "NegatedInvalidRegexp", tag: "!regexp='a('", expected: "invalid parameter for regexp: error parsing regexp: missing closing ): `a(`"},
+		{name: "AlternativeUnknownPattern", tag: "email|pattern=sku", expected: "unknown pattern sku"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := registry.ValidateVar("", "a", tc.tag, Options{})
+			var tagErr *TagError
+			if !errors.As(err, &tagErr) {
+				t.Fatalf("Expected error of type *TagError, got '%v'", err)
+			}
+			if tagErr.Reason != tc.expected {
+				t.Errorf("Expected reason '%s', got '%s'", tc.expected, tagErr.Reason)
+			}
+		})
+	}
+}
+
+// TestRegisterPattern tests that invalid patterns are rejected and that patterns belong to their registry.
+func TestRegisterPattern(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.RegisterPattern("broken", "[a-"); err == nil {
+		t.Error("Expected error for invalid pattern, got none")
+	}
+	if err := registry.RegisterPattern("", "^a$"); err == nil {
+		t.Error("Expected error for missing pattern name, got none")
+	}
+
+	// Registering a pattern recompiles the struct plans using it
+	type Product struct {
+		SKU string `validate:"pattern=sku"`
+	}
+	var tagErr *TagError
+	if err := registry.ValidateStruct(Product{SKU: "A"}, "en"); !errors.As(err, &tagErr) {
+		t.Errorf("Expected error of type *TagError, got '%v'", err)
+	}
+	if err := registry.RegisterPattern("sku", "^[A-Z]$"); err != nil {
+		t.Fatalf("Expected no error, got '%v'", err)
+	}
+	if err := registry.ValidateStruct(Product{SKU: "A"}, "en"); err != nil {
+		t.Errorf("Expected no error, got '%v'", err)
+	}
+
+	// Other registries don't see the pattern
+	if err := NewRegistry().ValidateVar("", "A", "pattern=sku", Options{}); !errors.As(err, &tagErr) {
+		t.Errorf("Expected error of type *TagError, got '%v'", err)
+	}
+}
+
+// TestOverridePatternRules tests that the regexp and pattern rules can be removed and overridden like other rules.
+func TestOverridePatternRules(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.ValidateVar("", "a", "pattern=x", Options{}); err == nil {
+		t.Fatal("Expected error for unknown pattern, got none")
+	}
+
+	// Tags referring to a removed rule are skipped
+	registry.RemoveValidationRule("pattern")
+	if err := registry.ValidateVar("", "a", "pattern=x", Options{}); err != nil {
+		t.Errorf("Expected no error, got '%v'", err)
+	}
+	var tagErr *TagError
+	if err := registry.ValidateVar("", "a", "email|pattern=x", Options{}); !errors.As(err, &tagErr) || tagErr.Reason != "unknown rule pattern" {
+		t.Errorf("Expected error of type *TagError for unknown rule, got '%v'", err)
+	}
+
+	// A registered rule replaces the built-in rule
+	registry.RegisterFieldRule("regexp", func(rc *RuleContext) error {
+		if rc.Param != "x" {
+			t.Errorf("Expected parameter 'x', got '%s'", rc.Param)
+		}
+		return errors.New("overridden")
+	})
+	if err := registry.ValidateVar("", "a", "regexp=x", Options{}); err == nil || err.Error() != "overridden" {
+		t.Errorf("Expected error 'overridden', got '%v'", err)
+	}
+}
@@ -0,0 +1,252 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"unicode"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// structPlan holds the parsed validation metadata of a struct type.
+// It is compiled once per struct type and registry state, so validation tags are not parsed on every call.
+type structPlan struct {
+	fields      []fieldPlan // fields holds the fields with validation rules or nested structs
+	structRule  FieldRule   // structRule is the struct-level rule registered for the type, nil if there is none
+	validatable bool        // validatable reports whether the type or a pointer to it implements Validatable
+	err         error       // err is the error of a malformed validation tag of a field, nil if all tags are valid
+}
+
+// fieldPlan holds the parsed validation metadata of a struct field.
+type fieldPlan struct {
+	index     int               // index is the index of the field in the struct
+	name      string            // name is the name of the field
+	aliases   map[string]string // aliases maps languages to the field name used in error messages
+	rules     *ruleChain        // rules is the compiled rule chain of the field, nil if it has no rules
+	nested    bool              // nested reports whether the field may hold a struct to validate recursively
+	anonymous bool              // anonymous reports whether the field is an embedded struct
+	err       *TagError         // err is the error of a malformed validation tag, nil if the tag is valid
+}
+
+// alias returns the field name used in error messages for the first language of the fallback chain
+// that has an alias, or the field name if none has.
+func (f *fieldPlan) alias(langs []string) string {
+	for _, lang := range langs {
+		if alias, ok := f.aliases[lang]; ok {
+			return alias
+		}
+	}
+	return f.name
+}
+
+// ruleChain holds the compiled validation rules applied to a value.
+type ruleChain struct {
+	rules     []rulePlan // rules holds the rules applied to the value itself
+	omitEmpty int        // omitEmpty is the index of the rule preceded by "omitempty", -1 if the chain has no omitempty
+	dive      *diveChain // dive holds the rules applied to the elements of the value, nil if the chain doesn't dive
+}
+
+// diveChain holds the compiled validation rules applied to the elements of a collection.
+type diveChain struct {
+	keys  *ruleChain // keys is the rule chain applied to map keys, nil if there are no key rules
+	elems *ruleChain // elems is the rule chain applied to the elements or map values
+}
+
+// rulePlan holds a validation rule resolved from a tag.
+type rulePlan struct {
+	name         string    // name is the name of the rule (e.g. "min")
+	param        string    // param is the rule parameter (e.g. "8" for "min=8")
+	args         []string  // args holds the space-separated arguments of the parameter (e.g. ["red", "green"] for "oneof=red green")
+	tag          string    // tag is the raw tag passed to the validation function (e.g. "min=8")
+	group        string    // group is the validation group the rule belongs to (e.g. "create"), empty if it always applies
+	validateFunc FieldRule // validateFunc is the validation function of the rule
+}
+
+// omitEmptyTag skips the remaining rules of a tag when the value is empty.
+const omitEmptyTag = "omitempty"
+
+// compileStruct parses the validation tags of a struct type into a struct plan,
+// resolving the rule names with the given rules and the pattern names with the given patterns. The struct rule is the struct-level rule registered for the type.
+// If a tag is malformed, the plan records a TagError, which is returned when validating the type.
+func compileStruct(typ reflect.Type, rules map[string]FieldRule, patterns map[string]*regexp.Regexp, structRule FieldRule) *structPlan {
+	plan := &structPlan{
+		structRule:  structRule,
+		validatable: reflect.PointerTo(typ).Implements(validatableType),
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldPlan := compileField(field.Name, field.Tag.Get("validate"), rules, patterns)
+		fieldPlan.index = i
+		fieldPlan.nested = field.IsExported() && isStructType(field.Type)
+		fieldPlan.anonymous = field.Anonymous
+		if fieldPlan.err == nil {
+			if err := checkDive(fieldPlan.rules, field.Type); err != nil {
+				fieldPlan.err = &TagError{Tag: field.Tag.Get("validate"), Reason: err.Error()}
+			}
+		}
+
+		if fieldPlan.err != nil {
+			fieldPlan.err.Field = joinPath(typ.Name(), field.Name)
+			plan.err = fieldPlan.err
+			return plan
+		}
+
+		// Skip fields that have nothing to validate
+		if fieldPlan.rules == nil && !fieldPlan.nested {
+			continue
+		}
+		plan.fields = append(plan.fields, fieldPlan)
+	}
+
+	return plan
+}
+
+// compileField parses the validation tag of a field into a field plan.
+// If the tag is malformed, the plan records a TagError.
+func compileField(name, tag string, rules map[string]FieldRule, patterns map[string]*regexp.Regexp) fieldPlan {
+	plan := fieldPlan{name: name}
+	if tag == "" {
+		return plan
+	}
+
+	items, err := parseTag(tag)
+	if err == nil {
+		plan.aliases = parseAliases(items, rules)
+		plan.rules, err = compileChain(items, rules, patterns)
+	}
+	if err != nil {
+		plan.err = &TagError{Field: name, Tag: tag, Reason: err.Error()}
+	}
+	return plan
+}
+
+// compileChain resolves the given tag items into a rule chain.
+// Items that don't refer to a registered rule, such as language aliases, are skipped.
+// The built-in regular expression rules are compiled with their parameter, see lookupRule.
+// It returns nil if the items contain no rules, and an error if a keyword such as "dive" is misused
+// or a regular expression is invalid.
+func compileChain(items []tagItem, rules map[string]FieldRule, patterns map[string]*regexp.Regexp) (*ruleChain, error) {
+	chain := &ruleChain{omitEmpty: -1}
+
+	for i, item := range items {
+		switch item.name {
+		case omitEmptyTag, diveTag, keysTag, endKeysTag:
+			if item.hasParam || item.group != "" || item.negate {
+				return nil, fmt.Errorf("%s takes no parameter, group or negation", item.name)
+			}
+		}
+
+		if item.name == omitEmptyTag {
+			// Empty values skip the rules following omitempty
+			if chain.omitEmpty < 0 {
+				chain.omitEmpty = len(chain.rules)
+			}
+			continue
+		}
+
+		if item.name == diveTag {
+			keyItems, valueItems := splitKeyTags(items[i+1:])
+			keys, err := compileChain(keyItems, rules, patterns)
+			if err != nil {
+				return nil, err
+			}
+			elems, err := compileChain(valueItems, rules, patterns)
+			if err != nil {
+				return nil, err
+			}
+			chain.dive = &diveChain{keys: keys, elems: elems}
+			break
+		}
+
+		// Negated rules and alternations are combined into a single rule
+		if item.composite() {
+			rule, err := compileComposite(item, rules, patterns)
+			if err != nil {
+				return nil, err
+			}
+			chain.rules = append(chain.rules, rule)
+			continue
+		}
+
+		// Retrieve the validation function for the rule name
+		validateFunc, ok, err := lookupRule(item, rules, patterns)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// Skip if validation rule is not found
+			continue
+		}
+		chain.rules = append(chain.rules, rulePlan{
+			name:         item.name,
+			param:        item.param,
+			args:         item.args,
+			tag:          item.raw(),
+			group:        item.group,
+			validateFunc: validateFunc,
+		})
+	}
+
+	if len(chain.rules) == 0 && chain.dive == nil {
+		return nil, nil
+	}
+	return chain, nil
+}
+
+// lookupRule returns the validation function registered for a rule, and reports whether the rule is registered.
+// The built-in regexp and pattern rules are compiled with their parameter, see compilePatternRule;
+// it returns an error if the regular expression is invalid or the pattern is not registered.
+func lookupRule(item tagItem, rules map[string]FieldRule, patterns map[string]*regexp.Regexp) (FieldRule, bool, error) {
+	validateFunc, ok := rules[item.name]
+	if !ok || !isBuiltinPatternRule(item.name, validateFunc) {
+		return validateFunc, ok, nil
+	}
+	validateFunc, err := compilePatternRule(item, patterns)
+	return validateFunc, true, err
+}
+
+// isGroupName reports whether a string is a valid validation group name,
+// made of letters, digits, underscores and hyphens.
+func isGroupName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// skipsEmpty reports whether the rules of the chain from the given index on are skipped for empty values.
+func (c *ruleChain) skipsEmpty(index int) bool {
+	return c.omitEmpty >= 0 && index >= c.omitEmpty
+}
+
+// parseAliases collects the language aliases of a field, such as "tr=Kullanıcı Adı".
+// Every item with a parameter that is not a rule is recorded with its name normalized as a language tag,
+// and the key matching the validation language is used. Rules named like languages, such as "lt" (Lithuanian)
+// and "ne" (Nepali), are never aliases.
+func parseAliases(items []tagItem, rules map[string]FieldRule) map[string]string {
+	var aliases map[string]string
+	for _, item := range items {
+		if _, isRule := rules[item.name]; item.hasParam && !item.composite() && !isRule {
+			if aliases == nil {
+				aliases = make(map[string]string)
+			}
+			aliases[locales.NormalizeTag(item.name)] = item.param
+		}
+	}
+	return aliases
+}
+
+// isStructType reports whether a type is a struct or a pointer to a struct.
+func isStructType(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct
+}
@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// TestCompileStruct tests parsing the validation tags of a struct type into a struct plan.
+func TestCompileStruct(t *testing.T) {
+	type Data struct {
+		Username string `validate:"required,min=3,unknown,en=User,tr=Kullanıcı"`
+		Ignored  string
+		Address  Address
+		Tags     []string `validate:"dive,max=10"`
+	}
+
+	plan := compileStruct(reflect.TypeOf(Data{}), defaultValidationRules(), nil, nil)
+
+	// Fields without rules or nested structs are skipped
+	if len(plan.fields) != 3 {
+		t.Fatalf("Expected 3 fields, got %d", len(plan.fields))
+	}
+
+	// Check the rules and aliases of the first field, unknown rules are skipped
+	username := plan.fields[0]
+	if username.index != 0 || username.name != "Username" || username.nested {
+		t.Errorf("Unexpected field plan %+v", username)
+	}
+	var names []string
+	for _, rule := range username.rules.rules {
+		names = append(names, rule.name+"="+rule.param)
+	}
+	if !reflect.DeepEqual(names, []string{"required=", "min=3"}) {
+		t.Errorf("Expected rules [required= min=3], got %v", names)
+	}
+	if username.alias([]string{"tr-TR", "tr", "en"}) != "Kullanıcı" || username.alias([]string{"de"}) != "Username" {
+		t.Errorf("Unexpected aliases %v", username.aliases)
+	}
+
+	// Check the nested struct field
+	if address := plan.fields[1]; address.index != 2 || !address.nested || address.rules != nil {
+		t.Errorf("Unexpected field plan %+v", address)
+	}
+
+	// Chains without omitempty apply all rules to empty values
+	if username.rules.omitEmpty != -1 {
+		t.Errorf("Expected no omitempty, got index %d", username.rules.omitEmpty)
+	}
+
+	// Check the dive rules
+	tags := plan.fields[2]
+	if tags.rules.dive == nil || tags.rules.dive.elems.rules[0].name != "max" {
+		t.Errorf("Expected dive with max rule, got %+v", tags.rules)
+	}
+}
+
+// TestCompileChainOmitEmpty tests recording the position of omitempty in a rule chain.
+func TestCompileChainOmitEmpty(t *testing.T) {
+	items, err := parseTag("required,omitempty,email,min=3")
+	if err != nil {
+		t.Fatalf("Expected no error, got '%v'", err)
+	}
+	chain, err := compileChain(items, defaultValidationRules(), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got '%v'", err)
+	}
+	if chain.omitEmpty != 1 || len(chain.rules) != 3 {
+		t.Fatalf("Expected omitempty before the second of 3 rules, got %d of %d", chain.omitEmpty, len(chain.rules))
+	}
+	if chain.skipsEmpty(0) || !chain.skipsEmpty(1) || !chain.skipsEmpty(3) {
+		t.Errorf("Unexpected rules skipped for empty values")
+	}
+}
+
+// TestStructPlanCache tests that struct plans are cached per type and invalidated when rules change.
+func TestStructPlanCache(t *testing.T) {
+	r := NewRegistry()
+	typ := reflect.TypeOf(User{})
+
+	// The plan is compiled once and then retrieved from the cache
+	state := r.state.Load()
+	plan := state.structPlan(typ)
+	if state.structPlan(typ) != plan {
+		t.Errorf("Expected struct plan to be cached")
+	}
+
+	// Registering a rule starts a new state with an empty cache
+	r.RegisterValidationRule("custom", func(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
+		return nil
+	})
+	if r.state.Load().structPlan(typ) == plan {
+		t.Errorf("Expected struct plan to be recompiled after registering a rule")
+	}
+}
+
+// BenchmarkCompileStruct benchmarks compiling the struct plan of a type,
+// which is the tag parsing work saved by the plan cache on every validation.
+func BenchmarkCompileStruct(b *testing.B) {
+	typ := reflect.TypeOf(BenchmarkUser{})
+	rules := defaultValidationRules()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		compileStruct(typ, rules, nil, nil)
+	}
+}
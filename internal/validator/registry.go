@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"reflect"
+	"regexp"
+	"sync"
+	"sync/atomic"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// Registry holds a set of validation rules and registered locale messages.
+// Each registry owns its rules and messages, so registering or removing them does not affect other registries.
+//
+// A Registry is safe for concurrent use by multiple goroutines.
+// Registrations are serialized and replace the registry state with an updated copy,
+// so validation reads the current state without locking.
+type Registry struct {
+	mu    sync.Mutex                    // mu serializes registrations
+	state atomic.Pointer[registryState] // state holds the current rules, messages and cached struct plans
+}
+
+// registryState holds an immutable set of rules and registered messages, and the caches derived from them.
+type registryState struct {
+	rules       map[string]FieldRule             // rules maps validation rule names to their validation functions
+	structRules map[reflect.Type]FieldRule       // structRules maps struct types to their registered struct-level rules
+	locales     map[string]locales.ErrorMessages // locales maps languages to registered messages overriding the bundled ones
+	patterns    map[string]*regexp.Regexp        // patterns maps pattern names to their registered regular expressions
+	plans       *sync.Map                        // plans caches the compiled *structPlan of each reflect.Type and the *fieldPlan of each varKey
+	resolved    sync.Map                         // resolved caches the resolved *locale of each requested and default language
+}
+
+// NewRegistry creates a new registry seeded with the default validation rules.
+func NewRegistry() *Registry {
+	r := &Registry{}
+	r.state.Store(&registryState{
+		rules:   defaultValidationRules(),
+		locales: make(map[string]locales.ErrorMessages),
+		plans:   &sync.Map{},
+	})
+	return r
+}
+
+// RegisterValidationRule registers a validation rule with a given name and validation function.
+// Registering a rule with the name of an existing rule, including a default rule, overrides it.
+func (r *Registry) RegisterValidationRule(name string, validateFunc ValidationRule) {
+	r.RegisterFieldRule(name, adaptCustomRule(validateFunc))
+}
+
+// RegisterFieldRule registers a validation rule that receives a rule context, giving access to the struct
+// containing the field and to the top-level struct. It overrides any existing rule with the same name.
+func (r *Registry) RegisterFieldRule(name string, validateFunc FieldRule) {
+	r.updateRules(func(rules map[string]FieldRule) {
+		rules[name] = validateFunc
+	})
+}
+
+// RegisterContextRule registers a validation rule that receives the context of the validation,
+// e.g. to honour the deadline of a request. It overrides any existing rule with the same name.
+func (r *Registry) RegisterContextRule(name string, validateFunc ContextRule) {
+	r.RegisterFieldRule(name, adaptContextRule(validateFunc))
+}
+
+// RemoveValidationRule removes the validation rule with the given name.
+// Tags referring to a removed rule are skipped during validation.
+func (r *Registry) RemoveValidationRule(name string) {
+	r.updateRules(func(rules map[string]FieldRule) {
+		delete(rules, name)
+	})
+}
+
+// validationRules returns the current rule set of the registry.
+// The returned map must not be modified.
+func (r *Registry) validationRules() map[string]FieldRule {
+	return r.state.Load().rules
+}
+
+// updateRules applies a modification to a copy of the current rule set and stores it in a new state.
+// Struct plans are compiled against a rule set, so the new state starts with an empty plan cache.
+func (r *Registry) updateRules(modify func(rules map[string]FieldRule)) {
+	r.update(func(next *registryState) {
+		next.rules = cloneMap(next.rules)
+		modify(next.rules)
+		next.plans = &sync.Map{}
+	})
+}
+
+// updateStructRules applies a modification to a copy of the registered struct rules and stores it in a new state.
+// Struct plans hold the struct rules of their type, so the new state starts with an empty plan cache.
+func (r *Registry) updateStructRules(modify func(structRules map[reflect.Type]FieldRule)) {
+	r.update(func(next *registryState) {
+		next.structRules = cloneMap(next.structRules)
+		modify(next.structRules)
+		next.plans = &sync.Map{}
+	})
+}
+
+// updateLocales applies a modification to a copy of the registered messages and stores it in a new state.
+// Struct plans don't depend on messages, so the new state keeps the plan cache of the current one.
+func (r *Registry) updateLocales(modify func(registered map[string]locales.ErrorMessages)) {
+	r.update(func(next *registryState) {
+		next.locales = cloneMap(next.locales)
+		modify(next.locales)
+	})
+}
+
+// updatePatterns applies a modification to a copy of the registered patterns and stores it in a new state.
+// Struct plans hold the patterns used by their rules, so the new state starts with an empty plan cache.
+func (r *Registry) updatePatterns(modify func(patterns map[string]*regexp.Regexp)) {
+	r.update(func(next *registryState) {
+		next.patterns = cloneMap(next.patterns)
+		modify(next.patterns)
+		next.plans = &sync.Map{}
+	})
+}
+
+// update stores a modified copy of the current state as the new state,
+// so that concurrent validations keep using the state they started with.
+// The copy shares the maps and plan cache of the current state, so the modification must replace
+// any map it changes instead of modifying it in place. Resolved locales are never shared.
+func (r *Registry) update(modify func(next *registryState)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.state.Load()
+	next := &registryState{
+		rules:       current.rules,
+		structRules: current.structRules,
+		locales:     current.locales,
+		patterns:    current.patterns,
+		plans:       current.plans,
+	}
+	modify(next)
+	r.state.Store(next)
+}
+
+// cloneMap returns a shallow copy of a map, with room for one more entry.
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	clone := make(map[K]V, len(m)+1)
+	for key, value := range m {
+		clone[key] = value
+	}
+	return clone
+}
+
+// structPlan returns the compiled struct plan of a struct type, compiling and caching it on first use.
+func (s *registryState) structPlan(typ reflect.Type) *structPlan {
+	if plan, ok := s.plans.Load(typ); ok {
+		return plan.(*structPlan)
+	}
+	plan, _ := s.plans.LoadOrStore(typ, compileStruct(typ, s.rules, s.patterns, s.structRules[typ]))
+	return plan.(*structPlan)
+}
@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// TestNewRegistry tests that a new registry is seeded with the default validation rules.
+func TestNewRegistry(t *testing.T) {
+	r := NewRegistry()
+	for name := range defaultValidationRules() {
+		if _, ok := r.validationRules()[name]; !ok {
+			t.Errorf("Expected default rule '%s' to be registered", name)
+		}
+	}
+}
+
+// TestRegistryIsolation tests that registering and removing rules does not affect other registries.
+func TestRegistryIsolation(t *testing.T) {
+	type Data struct {
+		Value string `validate:"min=3,custom"`
+	}
+
+	// Define a custom rule that always fails
+	failing := func(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
+		return errors.New("custom failed")
+	}
+
+	public := NewRegistry()
+	admin := NewRegistry()
+
+	// Register the custom rule on the admin registry only, and remove "min" from it
+	admin.RegisterValidationRule("custom", failing)
+	admin.RemoveValidationRule("min")
+
+	// The public registry still applies "min" and knows nothing about "custom"
+	err := public.ValidateStruct(Data{Value: "ab"}, "en")
+	if err == nil || err.Error() != "Value must be at least 3 characters long" {
+		t.Errorf("Expected min error from public registry, got '%v'", err)
+	}
+
+	// The admin registry skips "min" and applies "custom"
+	err = admin.ValidateStruct(Data{Value: "ab"}, "en")
+	if err == nil || err.Error() != "custom failed" {
+		t.Errorf("Expected custom error from admin registry, got '%v'", err)
+	}
+}
+
+// TestRegistryOverrideDefaultRule tests that a default rule can be overridden and is not reset by validation.
+func TestRegistryOverrideDefaultRule(t *testing.T) {
+	type Data struct {
+		Email string `validate:"email"`
+	}
+
+	r := NewRegistry()
+	r.RegisterValidationRule("email", func(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
+		return nil
+	})
+
+	// Validate twice to ensure the override is kept between calls
+	for i := 0; i < 2; i++ {
+		if err := r.ValidateStruct(Data{Email: "invalid"}, "en"); err != nil {
+			t.Errorf("Expected overridden email rule to pass, got '%v'", err)
+		}
+	}
+}
+
+// TestRegistryConcurrentUse tests validating from many goroutines while rules are being registered.
+// Run with -race to detect data races.
+func TestRegistryConcurrentUse(t *testing.T) {
+	r := NewRegistry()
+	passing := func(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		// Register and remove rules concurrently
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				name := fmt.Sprintf("custom%d", i)
+				r.RegisterValidationRule(name, passing)
+				r.RemoveValidationRule(name)
+			}
+		}(i)
+
+		// Validate concurrently
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if err := r.ValidateStruct(User{Username: "test", Password: "weak"}, "en"); err == nil {
+					t.Error("Expected validation to fail, but it passed")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,7 +1,6 @@
 package validator
 
 import (
-	"fmt"
 	"reflect"
 
 	"github.com/abdullahkabakk/validator/internal/validator/locales"
@@ -14,16 +13,19 @@ import (
 // The rule parameter is not used in this function but is included for consistency with other validation functions.
 func validateRequired(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
 	if isEmpty(value) {
-		return fmt.Errorf(messages["required"], fieldName)
+		return NewRuleError(messages, "required", fieldName)
 	}
 	return nil
 }
 
 // isEmpty checks if a value is empty.
-// It supports string, slice, and map types.
+// It supports string, slice, and map types, and considers invalid values empty.
 // For non-string types, it checks if the value is equal to its zero value.
 func isEmpty(value reflect.Value) bool {
 	switch value.Kind() {
+	case reflect.Invalid:
+		// Missing values, such as nil interfaces, are empty
+		return true
 	case reflect.String:
 		return value.Len() == 0
 	case reflect.Slice, reflect.Map:
@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// RuleContext holds the value being validated by a rule together with its surroundings,
+// such as the struct containing the field, so that rules can compare the value with other fields.
+//
+// A RuleContext is only valid during the call of the rule it is passed to, and must not be retained.
+type RuleContext struct {
+	Value    reflect.Value         // Value is the value being validated
+	Parent   reflect.Value         // Parent is the struct containing the field, invalid if the value is not a struct field
+	Top      reflect.Value         // Top is the top-level struct being validated
+	Messages locales.ErrorMessages // Messages holds the error messages for the validation language
+	Field    string                // Field is the field name used in error messages, which may be a language alias
+	Rule     string                // Rule is the name of the rule (e.g. "eqfield")
+	Param    string                // Param is the rule parameter with quotes and escapes removed (e.g. "Password" for "eqfield=Password")
+	Args     []string              // Args holds the space-separated arguments of the parameter (e.g. ["New York", "Paris"] for "oneof='New York' Paris")
+	Tag      string                // Tag is the raw tag of the rule (e.g. "eqfield=Password")
+	Groups   []string              // Groups holds the validation groups selected for the validation, nil if none are
+
+	ctx context.Context // ctx is the context of the validation, nil if it has none
+}
+
+// Context returns the context of the validation, e.g. to honour its deadline in a rule that queries a database.
+// It returns context.Background() if the validation was started without a context.
+func (rc *RuleContext) Context() context.Context {
+	if rc.ctx == nil {
+		return context.Background()
+	}
+	return rc.ctx
+}
+
+// FieldRule represents a function type for validation rules that need more than the field value,
+// such as cross-field comparisons. It takes a rule context as input and returns an error if validation fails.
+type FieldRule func(rc *RuleContext) error
+
+// ContextRule represents a function type for validation rules that need the context of the validation,
+// such as rules checking a uniqueness constraint in a database or reading request-scoped values.
+// It takes the context and a rule context as input and returns an error if validation fails.
+type ContextRule func(ctx context.Context, rc *RuleContext) error
+
+// adaptContextRule adapts a ContextRule to a FieldRule.
+func adaptContextRule(validateFunc ContextRule) FieldRule {
+	return func(rc *RuleContext) error {
+		return validateFunc(rc.Context(), rc)
+	}
+}
+
+// adaptValidationRule adapts a ValidationRule to a FieldRule.
+func adaptValidationRule(validateFunc ValidationRule) FieldRule {
+	return func(rc *RuleContext) error {
+		return validateFunc(rc.Value, rc.Messages, rc.Field, rc.Tag)
+	}
+}
+
+// adaptCustomRule adapts a ValidationRule registered by the user to a FieldRule.
+// A custom rule reports failures with plain errors, so every error it returns is marked as a validation failure,
+// which composite rules such as "!custom" and "custom|email" rely on.
+func adaptCustomRule(validateFunc ValidationRule) FieldRule {
+	return func(rc *RuleContext) error {
+		err := validateFunc(rc.Value, rc.Messages, rc.Field, rc.Tag)
+		if err != nil && !isFailure(err) {
+			return &ruleFailure{err: err}
+		}
+		return err
+	}
+}
+
+// ruleFailure marks an error returned by a custom rule as a validation failure, keeping its message.
+type ruleFailure struct {
+	err error
+}
+
+func (e *ruleFailure) Error() string {
+	return e.err.Error()
+}
+
+func (e *ruleFailure) Unwrap() error {
+	return e.err
+}
+
+// FieldByPath returns the field of the rule context referenced by a field name or dotted path.
+// A plain field name, such as "Password", is looked up in the parent struct or map;
+// a dotted path, such as "Account.Password", is looked up from the top-level struct or map.
+// Pointers and interfaces along the path are dereferenced, and missing map keys are returned as invalid values,
+// which rules treat like nil pointers. It returns false if the field cannot be found.
+func (rc *RuleContext) FieldByPath(path string) (reflect.Value, bool) {
+	current := rc.Parent
+	if strings.Contains(path, ".") {
+		current = rc.Top
+	}
+
+	for _, name := range strings.Split(path, ".") {
+		current = indirect(current)
+		switch {
+		case current.Kind() == reflect.Struct:
+			current = current.FieldByName(name)
+			if !current.IsValid() {
+				return reflect.Value{}, false
+			}
+		case current.Kind() == reflect.Map && current.Type().Key().Kind() == reflect.String:
+			current = current.MapIndex(reflect.ValueOf(name).Convert(current.Type().Key()))
+			if !current.IsValid() {
+				return reflect.Value{}, true
+			}
+		default:
+			return reflect.Value{}, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,140 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestFieldByPath tests looking up fields of the parent and top-level structs.
+func TestFieldByPath(t *testing.T) {
+	type Account struct {
+		Password string
+	}
+	type Form struct {
+		Account *Account
+		Name    string
+	}
+
+	form := Form{Account: &Account{Password: "secret"}, Name: "john"}
+	rc := &RuleContext{
+		Parent: reflect.ValueOf(form.Account).Elem(),
+		Top:    reflect.ValueOf(form),
+	}
+
+	testCases := []struct {
+		path     string      // Field name or dotted path
+		expected interface{} // Expected field value, nil if the field is not found
+	}{
+		{path: "Password", expected: "secret"},
+		{path: "Account.Password", expected: "secret"},
+		{path: "Name", expected: nil},
+		{path: "Account.Missing", expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			value, ok := rc.FieldByPath(tc.path)
+			if ok != (tc.expected != nil) {
+				t.Fatalf("FieldByPath(%q) found = %v, want %v", tc.path, ok, tc.expected != nil)
+			}
+			if ok && value.Interface() != tc.expected {
+				t.Errorf("FieldByPath(%q) = %v, want %v", tc.path, value.Interface(), tc.expected)
+			}
+		})
+	}
+}
+
+// TestRegisterFieldRule tests registering a rule that receives a rule context.
+func TestRegisterFieldRule(t *testing.T) {
+	type Data struct {
+		Kind  string
+		Value string `validate:"matchkind"`
+	}
+
+	r := NewRegistry()
+	r.RegisterFieldRule("matchkind", func(rc *RuleContext) error {
+		if rc.Parent.FieldByName("Kind").String() != rc.Value.String() {
+			return NewRuleError(rc.Messages, "matchKind", rc.Field)
+		}
+		return nil
+	})
+
+	if err := r.ValidateStruct(Data{Kind: "a", Value: "a"}, "en"); err != nil {
+		t.Errorf("Expected no error, got '%v'", err)
+	}
+	if err := r.ValidateStruct(Data{Kind: "a", Value: "b"}, "en"); err == nil {
+		t.Errorf("Expected error, got none")
+	}
+}
+
+type contextKey struct{}
+
+// TestValidateCtx tests passing the context to context rules and adapted legacy rules.
+func TestValidateCtx(t *testing.T) {
+	type Signup struct {
+		Username string `validate:"required,unique"`
+	}
+
+	r := NewRegistry()
+	r.RegisterContextRule("unique", func(ctx context.Context, rc *RuleContext) error {
+		taken, _ := ctx.Value(contextKey{}).(string)
+		if rc.Value.String() == taken {
+			return errors.New(rc.Field + " is taken")
+		}
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "john")
+	if err := r.ValidateCtx(ctx, Signup{Username: "jane"}, Options{}); err != nil {
+		t.Errorf("Expected no error, got '%v'", err)
+	}
+	if err := r.ValidateCtx(ctx, Signup{Username: "john"}, Options{}); err == nil || err.Error() != "Username is taken" {
+		t.Errorf("Expected error 'Username is taken', got '%v'", err)
+	}
+	if err := r.ValidateCtx(ctx, Signup{}, Options{}); err == nil || err.Error() != "Username is required" {
+		t.Errorf("Expected error 'Username is required', got '%v'", err)
+	}
+
+	// Without a context, rules receive context.Background()
+	if err := r.Validate(Signup{Username: "john"}, Options{}); err != nil {
+		t.Errorf("Expected no error without a context, got '%v'", err)
+	}
+}
+
+// TestValidateCtxCancelled tests that fields are no longer validated once the context is cancelled.
+func TestValidateCtxCancelled(t *testing.T) {
+	type Form struct {
+		First  string `validate:"cancel"`
+		Second string `validate:"count"`
+		Items  []int  `validate:"dive,count"`
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	r := NewRegistry()
+	r.RegisterContextRule("cancel", func(ctx context.Context, rc *RuleContext) error {
+		cancel()
+		return ctx.Err()
+	})
+	r.RegisterFieldRule("count", func(rc *RuleContext) error {
+		calls++
+		return nil
+	})
+
+	err := r.ValidateCtx(ctx, Form{Items: []int{1, 2, 3}}, Options{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got '%v'", err)
+	}
+	if calls != 0 {
+		t.Errorf("Expected no rule calls after cancellation, got %d", calls)
+	}
+
+	// An already cancelled context fails fast
+	if err := r.ValidateCtx(ctx, Form{}, Options{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got '%v'", err)
+	}
+}
@@ -1,21 +1,23 @@
 package validator
 
 import (
-	"fmt"
 	"reflect"
 	"regexp"
 
 	"github.com/abdullahkabakk/validator/internal/validator/locales"
 )
 
+// specialCharRegex matches any character that is not alphanumeric.
+var specialCharRegex = regexp.MustCompile(`[[:^alnum:]]`)
+
 // validateSpecialCharacter validates if a value contains special characters.
 // It checks if the value contains any special characters using the containsSpecialCharacter function,
-// and returns an error if the value does not contain any special characters.
+// and returns an error if the value is not a string or does not contain any special characters.
 // The fieldName parameter is used to customize the error message to include the name of the field being validated.
 // The rule parameter is not used in this function but is included for consistency with other validation functions.
 func validateSpecialCharacter(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
-	if !containsSpecialCharacter(value.String()) {
-		return fmt.Errorf(messages["specialCharacter"], fieldName)
+	if value.Kind() != reflect.String || !containsSpecialCharacter(value.String()) {
+		return NewRuleError(messages, "specialCharacter", fieldName)
 	}
 	return nil
 }
@@ -23,5 +25,5 @@ func validateSpecialCharacter(value reflect.Value, messages locales.ErrorMessage
 // containsSpecialCharacter checks if a string contains any special characters.
 // It uses a regular expression to match any character that is not alphanumeric.
 func containsSpecialCharacter(s string) bool {
-	return regexp.MustCompile(`[[:^alnum:]]`).MatchString(s)
+	return specialCharRegex.MatchString(s)
 }
@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Validatable is implemented by structs that validate invariants spanning several fields,
+// such as "at least one of Phone or Email". The Validate method is called after the fields of the struct
+// have been validated, with a rule context whose Value is the struct.
+//
+// The returned error is merged into the validation errors: ValidationErrors and FieldError values are
+// recorded with their paths prefixed by the path of the struct, and any other error is recorded for the struct itself.
+type Validatable interface {
+	Validate(rc *RuleContext) error
+}
+
+// validatableType is the reflect.Type of the Validatable interface.
+var validatableType = reflect.TypeOf((*Validatable)(nil)).Elem()
+
+// structRuleName is the rule name recorded for errors returned by struct-level validation.
+const structRuleName = "struct"
+
+// RegisterStructRule registers a struct-level validation rule for the type of the given struct value,
+// e.g. RegisterStructRule(Order{}, validateOrder), for types that cannot implement Validatable.
+// The rule is called like the Validate method of Validatable. It overrides any rule registered for the type.
+func (r *Registry) RegisterStructRule(structValue interface{}, validateFunc FieldRule) error {
+	typ := reflect.TypeOf(structValue)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return errors.New("input is not a struct")
+	}
+
+	r.updateStructRules(func(structRules map[reflect.Type]FieldRule) {
+		structRules[typ] = validateFunc
+	})
+	return nil
+}
+
+// validateStructLevel calls the struct-level rules of a struct: the Validate method if the struct implements
+// Validatable, and the struct rule registered for its type. Their errors are merged into the validation errors.
+// The Validate method of embedded structs is skipped, as it is promoted to the embedding struct and called there.
+// Struct-level rules are only applied to structs selected as a whole by partial validations.
+func (v *validation) validateStructLevel(plan *structPlan, value reflect.Value, path string, embedded bool) {
+	validatable := plan.validatable && !embedded
+	if (!validatable && plan.structRule == nil) || v.cancelled() || !v.filter.includes(path) {
+		return
+	}
+
+	v.rc = RuleContext{
+		Value:    value,
+		Top:      v.top,
+		Messages: v.messages,
+		Field:    value.Type().Name(),
+		Rule:     structRuleName,
+		Groups:   v.groups,
+		ctx:      v.ctx,
+	}
+
+	if validatable {
+		if hook, ok := validatableValue(value); ok {
+			v.mergeStructErrors(value, path, hook.Validate(&v.rc))
+		}
+	}
+	if plan.structRule != nil {
+		v.mergeStructErrors(value, path, plan.structRule(&v.rc))
+	}
+}
+
+// validatableValue returns the struct as a Validatable, taking its address if Validate has a pointer receiver.
+// Unaddressable structs are copied to take their address.
+func validatableValue(value reflect.Value) (Validatable, bool) {
+	if !value.CanInterface() {
+		return nil, false
+	}
+	if validatable, ok := value.Interface().(Validatable); ok {
+		return validatable, true
+	}
+
+	var ptr reflect.Value
+	if value.CanAddr() {
+		ptr = value.Addr()
+	} else {
+		ptr = reflect.New(value.Type())
+		ptr.Elem().Set(value)
+	}
+	validatable, ok := ptr.Interface().(Validatable)
+	return validatable, ok
+}
+
+// mergeStructErrors merges an error returned by a struct-level rule into the validation errors.
+// Paths of ValidationErrors and FieldError values are prefixed by the path of the struct,
+// and any other error is recorded for the struct itself.
+func (v *validation) mergeStructErrors(value reflect.Value, path string, err error) {
+	if err == nil {
+		return
+	}
+
+	var validationErrors ValidationErrors
+	var fieldErr FieldError
+	switch {
+	case errors.As(err, &validationErrors):
+		for _, fieldErr := range validationErrors {
+			v.errors = append(v.errors, prefixFieldError(fieldErr, path))
+		}
+	case errors.As(err, &fieldErr):
+		v.errors = append(v.errors, prefixFieldError(fieldErr, path))
+	default:
+		name := value.Type().Name()
+		if path == "" {
+			path = name
+		}
+		v.addError(name, path, structRuleName, "", value, err)
+	}
+}
+
+// prefixFieldError prefixes the path of a field error returned by a struct-level rule with the path of the struct.
+// If the field error has no path, its field name is used as the path.
+func prefixFieldError(fieldErr FieldError, path string) FieldError {
+	if fieldErr.Path == "" {
+		fieldErr.Path = fieldErr.Field
+	}
+	fieldErr.Path = joinPath(path, fieldErr.Path)
+	if fieldErr.Rule == "" {
+		fieldErr.Rule = structRuleName
+	}
+	return fieldErr
+}
@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+type Contact struct {
+	Name  string `validate:"required"`
+	Phone string
+	Email string
+}
+
+// Validate requires at least one of Phone or Email.
+func (c Contact) Validate(rc *RuleContext) error {
+	if c.Phone == "" && c.Email == "" {
+		return NewRuleError(rc.Messages, "required", "Phone or Email")
+	}
+	return nil
+}
+
+type Period struct {
+	Start int
+	End   int
+}
+
+// Validate reports an end before the start on the End field.
+func (p *Period) Validate(rc *RuleContext) error {
+	if p.End < p.Start {
+		return FieldError{Field: "End", Rule: "gtefield", Param: "Start", Value: p.End, Message: "End must be after Start"}
+	}
+	return nil
+}
+
+type Reservation struct {
+	Contact Contact
+	Period  *Period
+	Period2 Period
+}
+
+type EmbeddedContact struct {
+	Contact
+	Note string
+}
+
+// TestValidatable tests calling the Validate method of structs implementing Validatable.
+func TestValidatable(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name   string      // Name of the test case
+		input  interface{} // Input struct to be validated
+		expect []string    // Expected "path:rule" of the failing fields
+	}{
+		{
+			name:   "Valid",
+			input:  Contact{Name: "John", Email: "john@example.com"},
+			expect: nil,
+		},
+		{
+			name:   "FieldAndStructErrors",
+			input:  Contact{},
+			expect: []string{"Name:required", "Contact:struct"},
+		},
+		{
+			name:   "PointerReceiverOnValue",
+			input:  Period{Start: 2, End: 1},
+			expect: []string{"End:gtefield"},
+		},
+		{
+			name:   "NestedStructs",
+			input:  Reservation{Contact: Contact{Name: "John"}, Period: &Period{Start: 2, End: 1}, Period2: Period{Start: 3, End: 1}},
+			expect: []string{"Contact:struct", "Period.End:gtefield", "Period2.End:gtefield"},
+		},
+		{
+			name:   "EmbeddedStructCalledOnce",
+			input:  EmbeddedContact{Contact: Contact{Name: "John"}},
+			expect: []string{"EmbeddedContact:struct"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateStruct(tc.input, "en")
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Path+":"+fieldErr.Rule)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing fields %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestRegisterStructRule tests struct-level rules registered for a type.
+func TestRegisterStructRule(t *testing.T) {
+	type Order struct {
+		Items    int
+		Discount int
+	}
+	type Cart struct {
+		Order Order
+	}
+
+	r := NewRegistry()
+	err := r.RegisterStructRule(&Order{}, func(rc *RuleContext) error {
+		order := rc.Value.Interface().(Order)
+		if order.Discount > 0 && order.Items == 0 {
+			return ValidationErrors{{Field: "Discount", Rule: "discount", Message: "Discount requires items"}}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got '%v'", err)
+	}
+
+	err = r.ValidateStruct(Cart{Order: Order{Discount: 5}}, "en")
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 1 {
+		t.Fatalf("Expected one validation error, got '%v'", err)
+	}
+	if fieldErr := validationErrors[0]; fieldErr.Path != "Order.Discount" || fieldErr.Rule != "discount" {
+		t.Errorf("Expected error for Order.Discount, got %+v", fieldErr)
+	}
+
+	// Other registries are not affected
+	if err := NewRegistry().ValidateStruct(Cart{Order: Order{Discount: 5}}, "en"); err != nil {
+		t.Errorf("Expected no error from another registry, got '%v'", err)
+	}
+
+	if err := r.RegisterStructRule("not a struct", nil); err == nil {
+		t.Error("Expected error for a non-struct value")
+	}
+}
+
+// TestStructRuleParams tests that message parameters of struct-level rule errors are recorded.
+func TestStructRuleParams(t *testing.T) {
+	type Range struct {
+		Low  int
+		High int
+	}
+
+	r := NewRegistry()
+	_ = r.RegisterStructRule(Range{}, func(rc *RuleContext) error {
+		return NewRuleError(rc.Messages, "minLength", rc.Field, locales.Param{Name: "min", Value: 3})
+	})
+
+	err := r.ValidateStruct(Range{}, "en")
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 1 {
+		t.Fatalf("Expected one validation error, got '%v'", err)
+	}
+	fieldErr := validationErrors[0]
+	if fieldErr.Path != "Range" || fieldErr.Rule != "struct" || fieldErr.Params["min"] != 3 {
+		t.Errorf("Unexpected field error %+v", fieldErr)
+	}
+}
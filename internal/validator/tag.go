@@ -0,0 +1,261 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tagItem holds a single rule parsed from a validation tag, such as "min=8" or "oneof='New York' Paris@create".
+type tagItem struct {
+	name         string    // name is the name of the rule (e.g. "min")
+	param        string    // param is the rule parameter with quotes and escapes removed (e.g. "New York Paris")
+	args         []string  // args holds the space-separated arguments of the parameter (e.g. ["New York", "Paris"])
+	group        string    // group is the validation group of the rule (e.g. "create"), empty if it always applies
+	hasParam     bool      // hasParam reports whether the rule has a parameter
+	negate       bool      // negate reports whether the rule is negated with "!" (e.g. "!lowercase")
+	alternatives []tagItem // alternatives holds the rules of an alternation such as "email|e164", nil for a single rule
+}
+
+// raw returns the rule in the "name=param" form passed to validation rules as their tag.
+func (t tagItem) raw() string {
+	if !t.hasParam {
+		return t.name
+	}
+	return t.name + "=" + t.param
+}
+
+// ruleName returns the name of the rule reported in validation errors,
+// e.g. "!lowercase" for a negated rule and "email|e164" for an alternation.
+func (t tagItem) ruleName() string {
+	if t.alternatives != nil {
+		names := make([]string, len(t.alternatives))
+		for i, alternative := range t.alternatives {
+			names[i] = alternative.ruleName()
+		}
+		return strings.Join(names, "|")
+	}
+	if t.negate {
+		return "!" + t.name
+	}
+	return t.name
+}
+
+// composite reports whether the rule is negated or an alternation.
+func (t tagItem) composite() bool {
+	return t.negate || t.alternatives != nil
+}
+
+// TagError is returned when a validation tag is malformed, e.g. when a quote is not terminated.
+type TagError struct {
+	Field  string // Field is the name of the field with the malformed tag
+	Tag    string // Tag is the malformed validation tag
+	Reason string // Reason describes what is wrong with the tag
+}
+
+// Error returns a description of the malformed tag.
+func (e *TagError) Error() string {
+	return fmt.Sprintf("invalid validation tag %q on field %s: %s", e.Tag, e.Field, e.Reason)
+}
+
+// parseTag parses a validation tag into its rules.
+//
+// Rules are separated by commas, and a rule parameter follows the first "=" of the rule.
+// Parameters are split into arguments on spaces, e.g. "oneof=red green blue".
+// Single quotes at the start of an argument keep commas, equals signs, spaces and "@" literally,
+// e.g. "oneof='New York' Paris", while other single quotes are kept as they are, e.g. "tr=Kullanıcı'nın adı",
+// and a backslash escapes the character following it, e.g. "contains=\,". Within quotes, a backslash only escapes
+// a quote or a backslash, so that regular expressions such as "regexp='^\d{3}$'" can be written as is.
+// A validation group follows the last unquoted "@" of a rule, e.g. "required@create" or "min=8@create",
+// so an "@" in a parameter must be quoted or escaped, e.g. "eq='admin@root'" or "eq=admin\@root".
+// Rules may be negated with a "!" prefix, e.g. "!lowercase", and alternatives are separated by "|",
+// e.g. "email|e164", which passes if any of the alternatives passes.
+func parseTag(tag string) ([]tagItem, error) {
+	raws, err := splitUnquoted(tag, ',')
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]tagItem, 0, len(raws))
+	for _, raw := range raws {
+		item, err := parseTagItem(raw)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// parseTagItem parses a single rule of a validation tag, which may be an alternation of rules
+// separated by "|", such as "email|e164", each optionally negated with "!".
+func parseTagItem(raw string) (tagItem, error) {
+	if raw == "" {
+		return tagItem{}, fmt.Errorf("empty rule")
+	}
+
+	// Split the validation group off the rule, it applies to all alternatives
+	var group string
+	if i := lastUnquoted(raw, '@'); i > 0 && isGroupName(raw[i+1:]) {
+		raw, group = raw[:i], raw[i+1:]
+	}
+
+	raws, err := splitUnquoted(raw, '|')
+	if err != nil {
+		return tagItem{}, err
+	}
+	if len(raws) == 1 {
+		item, err := parseTagRule(raw)
+		item.group = group
+		return item, err
+	}
+
+	item := tagItem{group: group, alternatives: make([]tagItem, len(raws))}
+	for i, raw := range raws {
+		if raw == "" {
+			return tagItem{}, fmt.Errorf("empty alternative")
+		}
+		if item.alternatives[i], err = parseTagRule(raw); err != nil {
+			return tagItem{}, err
+		}
+	}
+	item.name = item.ruleName()
+	return item, nil
+}
+
+// parseTagRule parses a single rule without validation group, such as "min=8" or "!lowercase".
+func parseTagRule(raw string) (tagItem, error) {
+	var item tagItem
+	if strings.HasPrefix(raw, "!") {
+		raw = raw[1:]
+		item.negate = true
+	}
+
+	// Split the rule into its name and parameter at the first unquoted "="
+	name := raw
+	i := firstUnquoted(raw, '=')
+	if i >= 0 {
+		name = raw[:i]
+		item.hasParam = true
+	}
+	if name == "" {
+		return item, fmt.Errorf("missing rule name in %q", raw)
+	}
+	if strings.ContainsAny(name, "'\\ !") {
+		return item, fmt.Errorf("invalid rule name %q", name)
+	}
+	item.name = name
+
+	if item.hasParam {
+		param := raw[i+1:]
+		if param == "" {
+			return item, fmt.Errorf("missing parameter for %s", name)
+		}
+		item.param, item.args = unquote(param)
+	}
+	return item, nil
+}
+
+// splitUnquoted splits a string on a separator that is neither quoted nor escaped.
+// It returns an error if a quote is not terminated or the string ends with a backslash.
+func splitUnquoted(s string, sep byte) ([]string, error) {
+	var parts []string
+	start := 0
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			if i+1 == len(s) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+		case c == '\'' && (quoted || opensQuote(s, i)):
+			quoted = !quoted
+		case c == sep && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	return append(parts, s[start:]), nil
+}
+
+// firstUnquoted returns the index of the first occurrence of a character that is neither quoted nor escaped,
+// or -1 if there is none.
+func firstUnquoted(s string, c byte) int {
+	indices := unquotedIndices(s, c)
+	if len(indices) == 0 {
+		return -1
+	}
+	return indices[0]
+}
+
+// lastUnquoted returns the index of the last occurrence of a character that is neither quoted nor escaped,
+// or -1 if there is none.
+func lastUnquoted(s string, c byte) int {
+	indices := unquotedIndices(s, c)
+	if len(indices) == 0 {
+		return -1
+	}
+	return indices[len(indices)-1]
+}
+
+// unquotedIndices returns the indices of the occurrences of a character that are neither quoted nor escaped.
+func unquotedIndices(s string, c byte) []int {
+	var indices []int
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '\'' && (quoted || opensQuote(s, i)):
+			quoted = !quoted
+		case s[i] == c && !quoted:
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// opensQuote reports whether the quote at an index of a string opens a quoted argument: quotes only open at
+// the start of a rule, parameter or argument, so that apostrophes within words, such as in the Turkish alias
+// "tr=Kullanıcı'nın adı", are kept as they are.
+func opensQuote(s string, i int) bool {
+	return i == 0 || strings.IndexByte("=, |", s[i-1]) >= 0
+}
+
+// unquote removes the quotes and escapes of a rule parameter, and splits it into arguments on unquoted spaces.
+// Quoted empty strings are kept as empty arguments, so that an empty string can be passed as an argument.
+func unquote(param string) (string, []string) {
+	var value, arg strings.Builder
+	var args []string
+	quoted, inArg := false, false
+	for i := 0; i < len(param); i++ {
+		c := param[i]
+		switch {
+		case c == '\\' && i+1 < len(param) && (!quoted || param[i+1] == '\'' || param[i+1] == '\\'):
+			i++
+			c = param[i]
+		case c == '\'' && (quoted || opensQuote(param, i)):
+			quoted = !quoted
+			inArg = true
+			continue
+		case c == ' ' && !quoted:
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+			value.WriteByte(c)
+			continue
+		}
+		value.WriteByte(c)
+		arg.WriteByte(c)
+		inArg = true
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return value.String(), args
+}
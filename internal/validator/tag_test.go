@@ -0,0 +1,180 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestParseTag tests parsing validation tags with quoting, escaping and validation groups.
+func TestParseTag(t *testing.T) {
+	testCases := []struct {
+		tag      string    // Tag to parse
+		expected []tagItem // Expected rules
+	}{
+		{
+			tag: "required,min=8",
+			expected: []tagItem{
+				{name: "required"},
+				{name: "min", param: "8", args: []string{"8"}, hasParam: true},
+			},
+		},
+		{
+			tag: "oneof=red green  blue",
+			expected: []tagItem{
+				{name: "oneof", param: "red green  blue", args: []string{"red", "green", "blue"}, hasParam: true},
+			},
+		},
+		{
+			tag: "oneof='New York' Paris '',tr=Şehir Adı",
+			expected: []tagItem{
+				{name: "oneof", param: "New York Paris ", args: []string{"New York", "Paris", ""}, hasParam: true},
+				{name: "tr", param: "Şehir Adı", args: []string{"Şehir", "Adı"}, hasParam: true},
+			},
+		},
+		{
+			tag: "pattern='^[a-z]{2,4}=\\d$'",
+			expected: []tagItem{
+				{name: "pattern", param: "^[a-z]{2,4}=\\d$", args: []string{"^[a-z]{2,4}=\\d$"}, hasParam: true},
+			},
+		},
+		{
+			tag: `contains=\,,excludes=\'\\`,
+			expected: []tagItem{
+				{name: "contains", param: ",", args: []string{","}, hasParam: true},
+				{name: "excludes", param: `'\`, args: []string{`'\`}, hasParam: true},
+			},
+		},
+		{
+			tag: "required,tr=Kullanıcı'nın adı,oneof=it's 'a b'",
+			expected: []tagItem{
+				{name: "required"},
+				{name: "tr", param: "Kullanıcı'nın adı", args: []string{"Kullanıcı'nın", "adı"}, hasParam: true},
+				{name: "oneof", param: "it's a b", args: []string{"it's", "a b"}, hasParam: true},
+			},
+		},
+		{
+			tag: "eq=a=b",
+			expected: []tagItem{
+				{name: "eq", param: "a=b", args: []string{"a=b"}, hasParam: true},
+			},
+		},
+		{
+			tag: "required@create,min='8'@sign_up,eq='john@example'",
+			expected: []tagItem{
+				{name: "required", group: "create"},
+				{name: "min", param: "8", args: []string{"8"}, group: "sign_up", hasParam: true},
+				{name: "eq", param: "john@example", args: []string{"john@example"}, hasParam: true},
+			},
+		},
+		{
+			tag: `eq=admin\@root,regexp='^[a-z]+@example',min=8@create,oneof=a b@update`,
+			expected: []tagItem{
+				{name: "eq", param: "admin@root", args: []string{"admin@root"}, hasParam: true},
+				{name: "regexp", param: "^[a-z]+@example", args: []string{"^[a-z]+@example"}, hasParam: true},
+				{name: "min", param: "8", args: []string{"8"}, group: "create", hasParam: true},
+				{name: "oneof", param: "a b", args: []string{"a", "b"}, group: "update", hasParam: true},
+			},
+		},
+		{
+			tag: "eq=john@example.com",
+			expected: []tagItem{
+				{name: "eq", param: "john@example.com", args: []string{"john@example.com"}, hasParam: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			items, err := parseTag(tc.tag)
+			if err != nil {
+				t.Fatalf("Expected no error, got '%v'", err)
+			}
+			if !reflect.DeepEqual(items, tc.expected) {
+				t.Errorf("parseTag(%q) = %+v, want %+v", tc.tag, items, tc.expected)
+			}
+		})
+	}
+}
+
+// TestParseTagErrors tests that malformed tags are reported.
+func TestParseTagErrors(t *testing.T) {
+	testCases := map[string]string{
+		"required,,min=3":  "empty rule",
+		"min=3,":           "empty rule",
+		"=3":               `missing rule name in "=3"`,
+		"min=":             "missing parameter for min",
+		"oneof='a b":       "unterminated quote",
+		`contains=\`:       "trailing backslash",
+		"'min'=3":          `invalid rule name "'min'"`,
+		"required,dive=3":  "dive takes no parameter, group or negation",
+		"omitempty@update": "omitempty takes no parameter, group or negation",
+	}
+
+	for tag, expected := range testCases {
+		t.Run(tag, func(t *testing.T) {
+			items, err := parseTag(tag)
+			if err == nil {
+				_, err = compileChain(items, defaultValidationRules(), nil)
+			}
+			if err == nil || err.Error() != expected {
+				t.Errorf("Expected error '%s', got '%v'", expected, err)
+			}
+		})
+	}
+}
+
+// TestMalformedTag tests that validating a struct with a malformed tag returns a TagError.
+func TestMalformedTag(t *testing.T) {
+	type Form struct {
+		Name string `validate:"required"`
+		City string `validate:"oneof='New York"`
+	}
+
+	err := ValidateStruct(Form{}, "en")
+	var tagErr *TagError
+	if !errors.As(err, &tagErr) {
+		t.Fatalf("Expected error of type *TagError, got '%v'", err)
+	}
+	expected := `invalid validation tag "oneof='New York" on field Form.City: unterminated quote`
+	if err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+
+	// Single values report malformed tags as well
+	if err := defaultRegistry.ValidateVar("City", "Paris", "min=", Options{}); !errors.As(err, &tagErr) {
+		t.Errorf("Expected error of type *TagError, got '%v'", err)
+	}
+}
+
+// TestQuotedParameters tests rules receiving parameters with quoted spaces.
+func TestQuotedParameters(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Address struct {
+		City    string
+		ZipCode string `validate:"required_if=City 'New York'"`
+	}
+
+	err := ValidateStruct(Address{City: "New York"}, "en")
+	if err == nil || err.Error() != "ZipCode is required" {
+		t.Errorf("Expected error 'ZipCode is required', got '%v'", err)
+	}
+	if err := ValidateStruct(Address{City: "New"}, "en"); err != nil {
+		t.Errorf("Expected no error, got '%v'", err)
+	}
+}
+
+// TestApostropheInAlias tests that apostrophes within words don't open quotes, as in Turkish aliases.
+func TestApostropheInAlias(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Profile struct {
+		Name string `validate:"required,tr=Kullanıcı'nın adı"`
+	}
+
+	err := ValidateStruct(Profile{}, "tr")
+	if err == nil || err.Error() != "Kullanıcı'nın adı zorunludur" {
+		t.Errorf("Expected error 'Kullanıcı'nın adı zorunludur', got '%v'", err)
+	}
+}
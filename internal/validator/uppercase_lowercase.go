@@ -1,7 +1,6 @@
 package validator
 
 import (
-	"fmt"
 	"reflect"
 	"strings"
 
@@ -10,24 +9,24 @@ import (
 
 // validateUppercase validates if a value contains at least one uppercase letter.
 // It checks if the value contains at least one uppercase letter using the containsUppercase function,
-// and returns an error if the value does not contain any uppercase letters.
+// and returns an error if the value is not a string or does not contain any uppercase letters.
 // The fieldName parameter is used to customize the error message to include the name of the field being validated.
 // The rule parameter is not used in this function but is included for consistency with other validation functions.
 func validateUppercase(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
-	if !containsUppercase(value.String()) {
-		return fmt.Errorf(messages["uppercaseLetter"], fieldName)
+	if value.Kind() != reflect.String || !containsUppercase(value.String()) {
+		return NewRuleError(messages, "uppercaseLetter", fieldName)
 	}
 	return nil
 }
 
 // validateLowercase validates if a value contains at least one lowercase letter.
 // It checks if the value contains at least one lowercase letter using the containsLowercase function,
-// and returns an error if the value does not contain any lowercase letters.
+// and returns an error if the value is not a string or does not contain any lowercase letters.
 // The fieldName parameter is used to customize the error message to include the name of the field being validated.
 // The rule parameter is not used in this function but is included for consistency with other validation functions.
 func validateLowercase(value reflect.Value, messages locales.ErrorMessages, fieldName string, rule string) error {
-	if !containsLowercase(value.String()) {
-		return fmt.Errorf(messages["lowercaseLetter"], fieldName)
+	if value.Kind() != reflect.String || !containsLowercase(value.String()) {
+		return NewRuleError(messages, "lowercaseLetter", fieldName)
 	}
 	return nil
 }
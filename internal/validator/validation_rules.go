@@ -1,128 +1,463 @@
 package validator
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"github.com/abdullahkabakk/validator/internal/validator/locales"
 	"reflect"
-	"strconv"
-	"strings"
 )
 
 // ValidationRule represents a function type for custom validation rules.
 // It takes the field value to be validated, error messages, field name, and tag as input, and returns an error if validation fails.
 type ValidationRule func(reflect.Value, locales.ErrorMessages, string, string) error
 
-// validationRules maps validation rule names to their corresponding validation functions.
-var validationRules = make(map[string]ValidationRule)
+// defaultRegistry holds the validation rules used by the package-level functions.
+var defaultRegistry = NewRegistry()
 
-// RegisterValidationRule registers a custom validation rule with a given name and validation function.
+// RegisterValidationRule registers a custom validation rule with a given name and validation function
+// in the package-level registry.
 func RegisterValidationRule(name string, validateFunc ValidationRule) {
-	validationRules[name] = validateFunc
+	defaultRegistry.RegisterValidationRule(name, validateFunc)
 }
 
-// RegisterDefaultValidationRules registers the default validation rules provided by the package.
+// RegisterDefaultValidationRules registers the default validation rules provided by the package
+// in the package-level registry, restoring any default rule that was overridden or removed.
 func RegisterDefaultValidationRules() {
-	RegisterValidationRule("required", validateRequired)
-	RegisterValidationRule("min", validateMinLength)
-	RegisterValidationRule("max", validateMaxLength)
-	RegisterValidationRule("uppercase", validateUppercase)
-	RegisterValidationRule("lowercase", validateLowercase)
-	RegisterValidationRule("special", validateSpecialCharacter)
-	RegisterValidationRule("email", validateEmail)
-	RegisterValidationRule("date", validateDate)
+	defaultRegistry.updateRules(func(rules map[string]FieldRule) {
+		for name, validateFunc := range defaultValidationRules() {
+			rules[name] = validateFunc
+		}
+	})
+}
+
+// defaultValidationRules returns the default validation rules provided by the package.
+func defaultValidationRules() map[string]FieldRule {
+	return map[string]FieldRule{
+		"required":  adaptValidationRule(validateRequired),
+		"min":       adaptValidationRule(validateMinLength),
+		"max":       adaptValidationRule(validateMaxLength),
+		"len":       adaptValidationRule(validateLength),
+		"uppercase": adaptValidationRule(validateUppercase),
+		"lowercase": adaptValidationRule(validateLowercase),
+		"special":   adaptValidationRule(validateSpecialCharacter),
+		"email":     adaptValidationRule(validateEmail),
+		"date":      adaptValidationRule(validateDate),
+		"e164":      adaptValidationRule(validateE164),
+		"regexp":    validatePatternRule,
+		"pattern":   validatePatternRule,
+
+		// Numeric comparison rules
+		"gt":      validateGt,
+		"gte":     validateGte,
+		"lt":      validateLt,
+		"lte":     validateLte,
+		"eq":      validateEq,
+		"ne":      validateNe,
+		"between": validateBetween,
+
+		// Enumeration rules
+		"oneof":    validateOneOf,
+		"noneof":   validateNoneOf,
+		"oneofci":  validateOneOfCI,
+		"noneofci": validateNoneOfCI,
+
+		// Cross-field comparison rules
+		"eqfield":  validateEqField,
+		"nefield":  validateNeField,
+		"gtfield":  validateGtField,
+		"gtefield": validateGteField,
+		"ltfield":  validateLtField,
+		"ltefield": validateLteField,
+
+		// Conditional requirement rules
+		"required_if":       validateRequiredIf,
+		"required_unless":   validateRequiredUnless,
+		"required_with":     validateRequiredWith,
+		"required_with_all": validateRequiredWithAll,
+		"required_without":  validateRequiredWithout,
+		"excluded_if":       validateExcludedIf,
+		"excluded_with":     validateExcludedWith,
+	}
 }
 
-// ValidateStruct validates a struct based on the specified validation tags and language.
+// ValidateStruct validates a struct based on the specified validation tags and language,
+// using the validation rules of the package-level registry.
 // It returns an error if validation fails or if any required input is missing.
 func ValidateStruct(input interface{}, lang string) error {
+	return defaultRegistry.ValidateStruct(input, lang)
+}
+
+// Options configures a validation run.
+type Options struct {
+	Lang        string   // Lang is the BCP 47 language tag for error messages and field aliases, DefaultLang if empty
+	DefaultLang string   // DefaultLang is the language to fall back to when messages for Lang are missing, English if empty
+	Groups      []string // Groups selects the grouped rules to apply (e.g. "create" for "required@create"), ungrouped rules always apply
+	Fields      []string // Fields restricts validation to the fields with the given dotted paths (e.g. "Address.City"), all fields if empty
+	Except      []string // Except skips the fields with the given dotted paths
+}
+
+// ValidateStruct validates a struct based on the specified validation tags and language,
+// using the validation rules of the registry.
+// It returns an error if validation fails or if any required input is missing.
+func (r *Registry) ValidateStruct(input interface{}, lang string) error {
+	return r.Validate(input, Options{Lang: lang})
+}
+
+// Validate validates a struct based on the specified validation tags and options,
+// using the validation rules of the registry.
+// It returns an error if validation fails or if any required input is missing.
+// Pointers are dereferenced automatically, and nested and embedded structs are validated recursively.
+// Validation failures are returned as ValidationErrors, which can be retrieved with errors.As.
+//
+// Error messages are looked up along the fallback chain of the language, e.g. "pt-BR", "pt",
+// then the default language and finally English, so missing messages fall back per key.
+func (r *Registry) Validate(input interface{}, opts Options) error {
+	return r.ValidateCtx(context.Background(), input, opts)
+}
+
+// ValidateCtx validates a struct like Validate, passing the context to the validation rules.
+// Fields are no longer validated once the context is cancelled, and the context error is returned instead
+// of the validation errors collected so far.
+func (r *Registry) ValidateCtx(ctx context.Context, input interface{}, opts Options) error {
 	if input == nil {
 		return errors.New("input is nil")
 	}
 
+	// Dereference pointers until the underlying value is reached
 	value := reflect.ValueOf(input)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return errors.New("input is nil")
+		}
+		value = value.Elem()
+	}
+
 	if value.Kind() != reflect.Struct {
 		return errors.New("input is not a struct")
 	}
 
-	// Set the default language to English if not specified
-	if lang == "" {
-		lang = "en"
+	// Reject unknown fields, so that a misspelled field doesn't silently skip validation
+	if err := checkFieldPaths(value.Type(), opts.Fields, opts.Except); err != nil {
+		return err
+	}
+
+	v, err := r.newValidation(ctx, opts, value)
+	if err != nil {
+		return err
+	}
+	v.validateStruct(value, "", false)
+
+	return v.result()
+}
+
+// newValidation creates the state of a validation with the given options and top-level struct.
+// It returns an error if the error messages cannot be loaded or if the context is already cancelled.
+func (r *Registry) newValidation(ctx context.Context, opts Options, top reflect.Value) (*validation, error) {
+	// Set the language to the default language if not specified
+	if opts.Lang == "" {
+		opts.Lang = opts.DefaultLang
 	}
 
-	// Load error messages for the specified language
-	messages, err := locales.LoadMessagesFromJSON(lang)
+	state := r.state.Load()
+
+	// Load error messages for the specified language and its fallbacks
+	locale, err := state.resolveLocale(opts.Lang, opts.DefaultLang)
 	if err != nil {
-		// Fallback to English if error messages for the specified language are not available
-		messages, err = locales.LoadMessagesFromJSON("en")
-		if err != nil {
-			return errors.New("failed to load error messages")
-		}
-	}
-
-	var validationErrors []string
-
-	// Iterate over each struct field and validate based on the validation tags
-	for i := 0; i < value.NumField(); i++ {
-		field := value.Type().Field(i)
-		fieldValue := value.Field(i)
-		tag := field.Tag.Get("validate")
-		fieldAlias := field.Name
-
-		tags := strings.Split(tag, ",")
-		for _, tag := range tags {
-			parts := strings.Split(tag, "=")
-			// If the tag can be split with '=', it means there is a rule value
-			if len(parts) == 2 {
-				// Check if the language tag matches the specified language
-				if parts[0] == lang {
-					fieldAlias = parts[1]
+		return nil, errors.New("failed to load error messages")
+	}
+
+	// Fail fast if the context is already cancelled
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &validation{
+		ctx:      ctx,
+		done:     ctx.Done(),
+		state:    state,
+		langs:    locale.chain,
+		messages: locale.messages,
+		groups:   opts.Groups,
+		filter:   newFieldFilter(opts.Fields, opts.Except),
+		top:      top,
+	}, nil
+}
+
+// result returns the error that aborted the validation, the context error if the validation was cancelled,
+// or the collected validation errors, if any.
+func (v *validation) result() error {
+	if v.err != nil {
+		return v.err
+	}
+	if v.cancelled() {
+		return v.ctx.Err()
+	}
+
+	// Return the collected validation errors, if any
+	if len(v.errors) > 0 {
+		return v.errors
+	}
+
+	return nil
+}
+
+// validation holds the state of a single validation call.
+type validation struct {
+	ctx      context.Context       // ctx is the context passed to the validation rules
+	done     <-chan struct{}       // done is closed when the context is cancelled, nil if it can't be
+	state    *registryState        // state holds the rules and cached struct plans of the registry
+	langs    []string              // langs is the fallback chain of the language used for field aliases
+	messages locales.ErrorMessages // messages holds the error messages for the language
+	groups   []string              // groups holds the validation groups whose rules are applied
+	filter   *fieldFilter          // filter selects the fields to validate, nil to validate all fields
+	err      error                 // err is the error that aborted the validation, such as a malformed tag
+	top      reflect.Value         // top is the top-level struct being validated, invalid if the validated value is not a struct
+	rc       RuleContext           // rc is the rule context reused for every rule call
+	errors   ValidationErrors      // errors collects the validation errors encountered so far
+	visiting map[visitKey]bool     // visiting holds the addressable structs being validated, nil until the first one
+}
+
+// visitKey identifies a struct in memory. The type tells an embedded struct apart from the struct embedding it,
+// which share their address when the embedded struct is the first field.
+type visitKey struct {
+	addr uintptr      // addr is the address of the struct
+	typ  reflect.Type // typ is the type of the struct
+}
+
+// fieldRef identifies the struct field a value being validated belongs to.
+// For collection elements, it refers to the field holding the collection.
+type fieldRef struct {
+	parent reflect.Value // parent is the struct containing the field
+	name   string        // name is the name of the field
+	alias  string        // alias is the field name used in error messages
+}
+
+// validateStruct validates each field of a struct based on its compiled struct plan.
+// The path parameter is the dotted path of the struct from the validated input, empty for the input itself.
+// The embedded parameter reports whether the struct is embedded in the struct being validated.
+// A struct reached again while it is being validated, through cyclic pointers such as parent back-pointers,
+// is skipped so that the cycle is not followed forever; a struct shared by several fields is validated under each path.
+func (v *validation) validateStruct(value reflect.Value, path string, embedded bool) {
+	if !v.enter(value) {
+		return
+	}
+	defer v.leave(value)
+
+	plan := v.state.structPlan(value.Type())
+	if plan.err != nil {
+		v.err = plan.err
+		return
+	}
+
+	// Iterate over each field with validation rules or nested structs
+	for i := range plan.fields {
+		// Stop walking the fields once the context is cancelled
+		if v.cancelled() {
+			return
+		}
+
+		field := &plan.fields[i]
+		fieldValue := value.Field(field.index)
+		fieldPath := joinPath(path, field.name)
+
+		// Skip fields that are not selected, walking those with selected nested fields
+		validate, walk := v.filter.match(fieldPath)
+		if field.anonymous {
+			validate, walk = v.filter.match(path)
+		}
+		if !walk {
+			continue
+		}
+
+		if validate {
+			ref := fieldRef{parent: value, name: field.name, alias: field.alias(v.langs)}
+			v.applyRules(ref, fieldPath, fieldValue, field.rules)
+		} else if field.rules != nil && field.rules.dive != nil {
+			v.walkElements(fieldPath, fieldValue)
+		}
+
+		// Recurse into nested and embedded structs
+		if field.nested {
+			if nested, ok := structValue(fieldValue); ok {
+				// Embedded structs are flattened, so their fields keep the path of the embedding struct
+				if field.anonymous {
+					fieldPath = path
 				}
+				v.validateStruct(nested, fieldPath, field.anonymous)
 			}
 		}
+	}
 
-		// Iterate over each tag and apply corresponding validation rules
-		for _, tag := range tags {
-			// Split the tag into parts
-			parts := strings.Split(tag, "=")
+	v.validateStructLevel(plan, value, path, embedded)
+}
 
-			var ruleName string
+// enter records a struct as being validated and reports whether it was not already being validated.
+// Only addressable structs, which are reached through pointers or collections, can be part of a cycle and are recorded.
+func (v *validation) enter(value reflect.Value) bool {
+	if !value.CanAddr() {
+		return true
+	}
+	key := visitKey{addr: value.UnsafeAddr(), typ: value.Type()}
+	if v.visiting[key] {
+		return false
+	}
+	if v.visiting == nil {
+		v.visiting = make(map[visitKey]bool)
+	}
+	v.visiting[key] = true
+	return true
+}
 
-			// If the tag can be split with '=', it means there is a rule value
-			if len(parts) == 2 {
-				ruleName = parts[0]
-			} else {
-				// If it doesn't split, consider the entire tag as the rule name
-				ruleName = tag
-			}
+// leave removes a struct recorded by enter once it is validated.
+func (v *validation) leave(value reflect.Value) {
+	if value.CanAddr() {
+		delete(v.visiting, visitKey{addr: value.UnsafeAddr(), typ: value.Type()})
+	}
+}
 
-			// Retrieve the validation function for the rule name
-			validateFunc, ok := validationRules[ruleName]
-			if !ok {
-				// Skip if validation rule is not found
-				continue
-			}
+// cancelled reports whether the context of the validation is cancelled.
+func (v *validation) cancelled() bool {
+	if v.done == nil {
+		return false
+	}
+	select {
+	case <-v.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// inGroup reports whether the rules of a validation group are applied.
+// Ungrouped rules, with an empty group, are always applied.
+func (v *validation) inGroup(group string) bool {
+	if group == "" {
+		return true
+	}
+	for _, selected := range v.groups {
+		if selected == group {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRules applies a compiled rule chain to a value.
+// If the chain dives, the rules following the dive are applied to each element of the value.
+func (v *validation) applyRules(ref fieldRef, path string, value reflect.Value, chain *ruleChain) {
+	if chain == nil {
+		return
+	}
 
-			// Apply validation function and collect validation errors
-			if err := validateFunc(fieldValue, messages, fieldAlias, tag); err != nil {
-				validationErrors = append(validationErrors, err.Error())
+	// Dereference pointers, so that pointer fields are validated like the values they point to
+	value = derefValue(value)
+
+	// Iterate over each rule and apply the corresponding validation function
+	for i, rule := range chain.rules {
+		// Skip the remaining rules once the context is cancelled
+		if v.cancelled() {
+			return
+		}
+
+		// Skip the remaining rules of empty values following omitempty
+		if i == chain.omitEmpty && isEmpty(value) {
+			return
+		}
+
+		// Skip rules of validation groups that are not selected
+		if !v.inGroup(rule.group) {
+			continue
+		}
+
+		v.rc = RuleContext{
+			Value:    value,
+			Parent:   ref.parent,
+			Top:      v.top,
+			Messages: v.messages,
+			Field:    ref.alias,
+			Rule:     rule.name,
+			Param:    rule.param,
+			Args:     rule.args,
+			Tag:      rule.tag,
+			Groups:   v.groups,
+			ctx:      v.ctx,
+		}
+
+		// Apply validation function and collect validation errors
+		if err := rule.validateFunc(&v.rc); err != nil {
+			v.addError(ref.name, path, rule.name, rule.param, value, err)
+		}
+	}
+
+	if chain.dive != nil {
+		if chain.skipsEmpty(len(chain.rules)) && isEmpty(value) {
+			return
+		}
+		v.dive(ref, path, value, chain.dive)
+	}
+}
+
+// derefValue dereferences pointers to non-struct values.
+// Nil pointers are replaced by the zero value of the type they point to, so that a nil *string
+// is validated like an empty string. Pointers to structs are kept, so that nil structs can be told apart.
+func derefValue(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr && value.Type().Elem().Kind() != reflect.Struct {
+		if value.IsNil() {
+			typ := value.Type().Elem()
+			for typ.Kind() == reflect.Ptr && typ.Elem().Kind() != reflect.Struct {
+				typ = typ.Elem()
 			}
+			return reflect.Zero(typ)
 		}
+		value = value.Elem()
 	}
+	return value
+}
 
-	// Return concatenated validation errors, if any
-	if len(validationErrors) > 0 {
-		return errors.New(strings.Join(validationErrors, ";\n"))
+// addError records a validation error for a field.
+// If the validation error is a RuleError, its message parameters are recorded as well.
+func (v *validation) addError(name, path, rule, param string, value reflect.Value, err error) {
+	fieldErr := FieldError{
+		Field:   name,
+		Path:    path,
+		Rule:    rule,
+		Param:   param,
+		Value:   fieldInterface(value),
+		Message: err.Error(),
 	}
 
-	return nil
+	var ruleErr *RuleError
+	if errors.As(err, &ruleErr) {
+		fieldErr.Params = ruleErr.Params
+	}
+
+	v.errors = append(v.errors, fieldErr)
+}
+
+// structValue dereferences pointers and reports whether the underlying value is a struct.
+// Nil pointers are not considered structs, so they are not validated recursively.
+func structValue(value reflect.Value) (reflect.Value, bool) {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	return value, value.Kind() == reflect.Struct
+}
+
+// joinPath appends a field name to a dotted struct path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
 }
 
-// parseRule extracts the length from the rule string.
-func parseRule(rule string) (int, error) {
-	parts := strings.Split(rule, "=")
-	if len(parts) != 2 {
-		return 0, fmt.Errorf("invalid rule format: %s", rule)
+// fieldInterface returns the value held by a field, or nil if the field is unexported and cannot be accessed.
+func fieldInterface(value reflect.Value) interface{} {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
 	}
-	return strconv.Atoi(parts[1])
+	return value.Interface()
 }
@@ -2,7 +2,9 @@ package validator
 
 import (
 	"errors"
+	"reflect"
 	"testing"
+	"time"
 )
 
 type User struct {
@@ -77,3 +79,359 @@ func TestValidateStruct(t *testing.T) {
 		})
 	}
 }
+
+type Address struct {
+	City    string `validate:"required"`
+	ZipCode string `validate:"min=5"`
+}
+
+type Profile struct {
+	Bio string `validate:"max=10"`
+}
+
+type Base struct {
+	ID string `validate:"required"`
+}
+
+type Customer struct {
+	Base
+	Name    string `validate:"required"`
+	Address Address
+	Profile *Profile
+}
+
+// TestValidateStructNested tests the recursive validation of nested, pointer and embedded structs.
+func TestValidateStructNested(t *testing.T) {
+	// Register default validation rules
+	RegisterDefaultValidationRules()
+
+	// Define test cases
+	testCases := []struct {
+		name   string      // Name of the test case
+		input  interface{} // Input struct to be validated
+		expect []string    // Expected paths of the failing fields
+	}{
+		{
+			name: "ValidCustomer",
+			input: Customer{
+				Base:    Base{ID: "1"},
+				Name:    "John",
+				Address: Address{City: "Istanbul", ZipCode: "34000"},
+				Profile: &Profile{Bio: "Hello"},
+			},
+			expect: nil,
+		},
+		{
+			name:   "InvalidNestedStruct",
+			input:  Customer{Base: Base{ID: "1"}, Name: "John", Address: Address{ZipCode: "340"}},
+			expect: []string{"Address.City", "Address.ZipCode"},
+		},
+		{
+			name: "InvalidPointerStruct",
+			input: Customer{
+				Base:    Base{ID: "1"},
+				Name:    "John",
+				Address: Address{City: "Istanbul", ZipCode: "34000"},
+				Profile: &Profile{Bio: "Hello, world!"},
+			},
+			expect: []string{"Profile.Bio"},
+		},
+		{
+			name:   "InvalidEmbeddedStruct",
+			input:  &Customer{Name: "John", Address: Address{City: "Istanbul", ZipCode: "34000"}},
+			expect: []string{"ID"},
+		},
+	}
+
+	// Run test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateStruct(tc.input, "en")
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			// Check the paths of the failing fields
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			if len(validationErrors) != len(tc.expect) {
+				t.Fatalf("Expected %d field errors, got %d: %v", len(tc.expect), len(validationErrors), err)
+			}
+			for i, path := range tc.expect {
+				if validationErrors[i].Path != path {
+					t.Errorf("Expected path '%s', got '%s'", path, validationErrors[i].Path)
+				}
+			}
+		})
+	}
+}
+
+// TestValidateStructNilPointer tests that a nil pointer input is rejected.
+func TestValidateStructNilPointer(t *testing.T) {
+	var customer *Customer
+	err := ValidateStruct(customer, "en")
+	if err == nil || err.Error() != "input is nil" {
+		t.Errorf("Expected error 'input is nil', got '%v'", err)
+	}
+}
+
+// TestValidateStructCycle tests that cyclic pointers are validated once instead of being followed forever.
+func TestValidateStructCycle(t *testing.T) {
+	type Node struct {
+		Name     string `validate:"required"`
+		Parent   *Node
+		Children []*Node
+	}
+
+	root := &Node{}
+	child := &Node{Name: "child", Parent: root}
+	root.Children = []*Node{child, child}
+	root.Parent = root
+
+	done := make(chan error, 1)
+	go func() { done <- ValidateStruct(root, "en") }()
+
+	select {
+	case err := <-done:
+		if err == nil || err.Error() != "Name is required" {
+			t.Errorf("Expected error 'Name is required', got '%v'", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Validation of a cyclic struct did not return")
+	}
+
+	// A struct shared by several fields is not a cycle and is reported under each path
+	type Item struct {
+		SKU string `validate:"required"`
+	}
+	type Shared struct {
+		A     *Item
+		B     *Item
+		Items []*Item `validate:"dive"`
+	}
+
+	item := &Item{}
+	err := ValidateStruct(Shared{A: item, B: item, Items: []*Item{item, item}}, "en")
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected validation errors, got '%v'", err)
+	}
+	paths := make([]string, len(validationErrors))
+	for i, fieldErr := range validationErrors {
+		paths[i] = fieldErr.Path
+	}
+	if expected := []string{"A.SKU", "B.SKU", "Items[0].SKU", "Items[1].SKU"}; !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected paths %v, got %v", expected, paths)
+	}
+}
+
+type BenchmarkUser struct {
+	Username string   `validate:"required,min=3,max=20,en=Username,tr=Kullanıcı Adı"`
+	Email    string   `validate:"required,email"`
+	Password string   `validate:"required,min=8,max=64,uppercase,lowercase,special"`
+	Birthday string   `validate:"required,date"`
+	Address  Address  `validate:"required"`
+	Tags     []string `validate:"dive,required,max=10"`
+}
+
+// BenchmarkValidateStruct benchmarks the validation of a valid struct.
+func BenchmarkValidateStruct(b *testing.B) {
+	user := BenchmarkUser{
+		Username: "john_doe",
+		Email:    "john@example.com",
+		Password: "Secret!Password",
+		Birthday: "1990-01-01",
+		Address:  Address{City: "Istanbul", ZipCode: "34000"},
+		Tags:     []string{"admin", "staff"},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := ValidateStruct(user, "en"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestOmitEmpty tests that empty values skip the rules following omitempty.
+func TestOmitEmpty(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Contact struct {
+		Email    string   `validate:"omitempty,email"`
+		Birthday *string  `validate:"omitempty,date"`
+		Website  string   `validate:"max=5,omitempty,min=3"`
+		Tags     []string `validate:"omitempty,dive,min=2"`
+	}
+
+	invalidDate := "2024-02-30"
+	validDate := "2024-02-28"
+
+	// Define test cases
+	testCases := []struct {
+		name   string   // Name of the test case
+		input  Contact  // Input struct to be validated
+		expect []string // Expected "field:rule" of the failing fields
+	}{
+		{
+			name:   "EmptyOptionalFields",
+			input:  Contact{},
+			expect: nil,
+		},
+		{
+			name:   "ValidOptionalFields",
+			input:  Contact{Email: "john@example.com", Birthday: &validDate, Website: "abc", Tags: []string{"go"}},
+			expect: nil,
+		},
+		{
+			name:   "InvalidOptionalFields",
+			input:  Contact{Email: "invalid", Birthday: &invalidDate, Website: "ab", Tags: []string{"a"}},
+			expect: []string{"Email:email", "Birthday:date", "Website:min", "Tags:min"},
+		},
+	}
+
+	// Run test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateStruct(tc.input, "en")
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Field+":"+fieldErr.Rule)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing fields %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestPointerFields tests that pointer fields are validated like the values they point to.
+func TestPointerFields(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Profile struct {
+		Nickname *string `validate:"required,min=3"`
+		Age      *int    `validate:"required"`
+	}
+
+	short := "ab"
+	age := 30
+
+	// A nil pointer is validated like an empty value
+	err := ValidateStruct(Profile{Age: &age}, "en")
+	expected := "Nickname is required;\nNickname must be at least 3 characters long"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+
+	// A non-nil pointer is validated like the value it points to
+	err = ValidateStruct(Profile{Nickname: &short, Age: &age}, "en")
+	if err == nil || err.Error() != "Nickname must be at least 3 characters long" {
+		t.Errorf("Expected min error, got '%v'", err)
+	}
+}
+
+// TestValidationGroups tests applying grouped rules only when their group is selected.
+func TestValidationGroups(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Account struct {
+		Email    string `validate:"required,email"`
+		Password string `validate:"required@create,omitempty,min=8"`
+		Nickname string `validate:"required@update,max=10"`
+		Role     string `validate:"required@admin"`
+	}
+
+	testCases := []struct {
+		name   string   // Name of the test case
+		groups []string // Selected validation groups
+		input  Account  // Input struct to be validated
+		expect []string // Expected "field:rule" of the failing fields
+	}{
+		{
+			name:   "Ungrouped",
+			input:  Account{Email: "john@example.com"},
+			expect: nil,
+		},
+		{
+			name:   "Create",
+			groups: []string{"create"},
+			input:  Account{Email: "john@example.com"},
+			expect: []string{"Password:required"},
+		},
+		{
+			name:   "Update",
+			groups: []string{"update"},
+			input:  Account{Email: "invalid", Password: "short"},
+			expect: []string{"Email:email", "Password:min", "Nickname:required"},
+		},
+		{
+			name:   "SeveralGroups",
+			groups: []string{"create", "admin"},
+			input:  Account{Email: "john@example.com", Password: "12345678"},
+			expect: []string{"Role:required"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.Validate(tc.input, Options{Groups: tc.groups})
+			if tc.expect == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+
+			var validationErrors ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+			}
+			var got []string
+			for _, fieldErr := range validationErrors {
+				got = append(got, fieldErr.Field+":"+fieldErr.Rule)
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("Expected failing fields %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+// TestGroupSyntaxInParameters tests that a validation group follows rules with parameters,
+// and that a quoted or escaped "@" in a rule parameter is not taken as a validation group.
+func TestGroupSyntaxInParameters(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Login struct {
+		User     string `validate:"eq=admin\\@root"`
+		Email    string `validate:"regexp='^[a-z]+@example'"`
+		Password string `validate:"min=8@create"`
+	}
+
+	err := defaultRegistry.Validate(Login{User: "ZZZ", Email: "ZZZ", Password: "short"}, Options{Groups: []string{"create"}})
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 3 {
+		t.Fatalf("Expected 3 validation errors, got '%v'", err)
+	}
+	if err := defaultRegistry.Validate(Login{User: "admin@root", Email: "john@example", Password: "short"}, Options{Groups: []string{"update"}}); err != nil {
+		t.Errorf("Expected no error, got '%v'", err)
+	}
+}
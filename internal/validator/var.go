@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+)
+
+// defaultVarName is the field name used in error messages for variables validated without a name.
+const defaultVarName = "Value"
+
+// varKey identifies the compiled field plan of a variable validated with a tag.
+type varKey struct {
+	name string // name is the field name of the variable
+	tag  string // tag is the validation tag of the variable
+}
+
+// ValidateVar validates a single input value, such as a query parameter or a command-line flag, against a validation tag
+// such as "required,email", using the validation rules of the registry. The name is the field name used in error messages
+// and paths, "Value" if empty, and the tag may hold language aliases for it, such as "tr=E-posta".
+// Struct values and struct elements of collections are validated recursively.
+// Validation failures are returned as ValidationErrors, like for structs.
+func (r *Registry) ValidateVar(name string, input interface{}, tag string, opts Options) error {
+	return r.ValidateVarCtx(context.Background(), name, input, tag, opts)
+}
+
+// ValidateVarCtx validates a single value like ValidateVar, passing the context to the validation rules.
+func (r *Registry) ValidateVarCtx(ctx context.Context, name string, input interface{}, tag string, opts Options) error {
+	if name == "" {
+		name = defaultVarName
+	}
+
+	// Struct values are the top-level struct of the rules applied to their fields
+	value := reflect.ValueOf(input)
+	top, _ := structValue(value)
+
+	v, err := r.newValidation(ctx, opts, top)
+	if err != nil {
+		return err
+	}
+
+	field := v.state.varPlan(name, tag)
+	if field.err != nil {
+		return field.err
+	}
+	ref := fieldRef{name: name, alias: field.alias(v.langs)}
+	v.validateElement(ref, name, value, field.rules)
+
+	return v.result()
+}
+
+// varPlan returns the compiled field plan of a variable, compiling and caching it on first use.
+// The plan records a TagError if the tag is malformed.
+func (s *registryState) varPlan(name, tag string) *fieldPlan {
+	key := varKey{name: name, tag: tag}
+	if plan, ok := s.plans.Load(key); ok {
+		return plan.(*fieldPlan)
+	}
+
+	field := compileField(name, tag, s.rules, s.patterns)
+	plan, _ := s.plans.LoadOrStore(key, &field)
+	return plan.(*fieldPlan)
+}
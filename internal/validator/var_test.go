@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestValidateVar tests validating single values against a validation tag.
+func TestValidateVar(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	testCases := []struct {
+		name     string      // Name of the test case
+		field    string      // Field name of the value
+		input    interface{} // Value to be validated
+		tag      string      // Validation tag
+		lang     string      // Language of the error messages
+		expected string      // Expected error message, empty if validation passes
+	}{
+		{
+			name:  "Valid",
+			field: "Email",
+			input: "john@example.com",
+			tag:   "required,email",
+		},
+		{
+			name:     "DefaultName",
+			input:    "",
+			tag:      "required",
+			expected: "Value is required",
+		},
+		{
+			name:     "Alias",
+			field:    "Email",
+			input:    "invalid",
+			tag:      "required,email,tr=E-posta",
+			lang:     "tr",
+			expected: "E-posta geçerli bir e-posta adresi değil",
+		},
+		{
+			name:     "NilPointer",
+			field:    "Name",
+			input:    (*string)(nil),
+			tag:      "required",
+			expected: "Name is required",
+		},
+		{
+			name:     "Dive",
+			field:    "Tags",
+			input:    []string{"go", ""},
+			tag:      "dive,required",
+			expected: "Tags is required",
+		},
+		{
+			name:     "NotString",
+			field:    "Email",
+			input:    5,
+			tag:      "email",
+			expected: "Email is not a valid email address",
+		},
+		{
+			name:     "OmitEmpty",
+			field:    "Email",
+			input:    "",
+			tag:      "omitempty,email",
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultRegistry.ValidateVar(tc.field, tc.input, tc.tag, Options{Lang: tc.lang})
+			if tc.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got '%v'", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected error '%s', got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+// TestValidateVarErrors tests the structured errors of validated values.
+func TestValidateVarErrors(t *testing.T) {
+	RegisterDefaultValidationRules()
+
+	type Item struct {
+		SKU string `validate:"required"`
+	}
+
+	err := defaultRegistry.ValidateVar("items", []Item{{SKU: "A"}, {}}, "required,dive", Options{})
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected error of type ValidationErrors, got '%v'", err)
+	}
+	expected := ValidationErrors{{Field: "SKU", Path: "items[1].SKU", Rule: "required", Value: "", Message: "SKU is required"}}
+	if !reflect.DeepEqual(validationErrors, expected) {
+		t.Errorf("Expected errors %+v, got %+v", expected, validationErrors)
+	}
+}
@@ -1,11 +1,75 @@
 package validator
 
-import "github.com/abdullahkabakk/validator/internal/validator"
+import (
+	"context"
+	"io"
+	"io/fs"
+	"sync"
+
+	"github.com/abdullahkabakk/validator/internal/validator"
+	"github.com/abdullahkabakk/validator/internal/validator/locales"
+)
+
+// ErrorMessages maps message keys (e.g. "required") to localized error messages.
+type ErrorMessages = locales.ErrorMessages
+
+// ValidationRule represents a function type for custom validation rules.
+// It takes the field value to be validated, error messages, field name, and tag as input, and returns an error if validation fails.
+type ValidationRule = validator.ValidationRule
+
+// FieldError describes a single validation failure, including the field name, struct path,
+// rule name, rule parameter, offending value and localized message.
+type FieldError = validator.FieldError
+
+// ValidationErrors is the error returned by Validate and ValidateWithLang when validation fails.
+// Use errors.As to retrieve it and inspect the individual field errors.
+type ValidationErrors = validator.ValidationErrors
+
+// RuleContext holds the value being validated by a rule together with the struct containing the field
+// and the top-level struct, so that rules can compare the value with other fields.
+type RuleContext = validator.RuleContext
+
+// FieldRule represents a function type for validation rules that receive a RuleContext.
+type FieldRule = validator.FieldRule
+
+// ContextRule represents a function type for validation rules that receive the context passed to ValidateCtx,
+// e.g. to honour the deadline of a request when checking a uniqueness constraint in a database.
+type ContextRule = validator.ContextRule
+
+// MessageParam is a named parameter of an error message, referenced in message templates as {name}.
+type MessageParam = locales.Param
+
+// RuleError is returned by validation rules to report a failure with a localized message and its parameters.
+type RuleError = validator.RuleError
+
+// Validatable is implemented by structs that validate invariants spanning several fields,
+// such as "at least one of Phone or Email". Its Validate method is called after the fields of the struct,
+// and of nested structs, have been validated, and the returned errors are merged into the validation errors.
+type Validatable = validator.Validatable
+
+// TagError is returned when a validation tag is malformed, e.g. when a quote is not terminated.
+type TagError = validator.TagError
+
+// NewRuleError renders the message with the given key for a field and returns it as a RuleError.
+// Custom validation rules can use it to report failures, exposing their parameters (e.g. the allowed values)
+// to message templates such as "{field} must be one of {values}" and to the resulting FieldError.
+func NewRuleError(messages ErrorMessages, key, field string, params ...MessageParam) error {
+	return validator.NewRuleError(messages, key, field, params...)
+}
 
 // Validator represents a validation instance that can be used to validate structs.
+// Each Validator owns its validation rules, seeded with the default rules at construction,
+// so rules registered or removed on one Validator do not affect other instances.
+//
+// A Validator is safe for concurrent use by multiple goroutines: rules can be registered or removed
+// while other goroutines are validating. DefaultLang, however, is a plain field, so it should be set
+// (directly or with SetLang) before the validator is shared between goroutines.
 type Validator struct {
 	// DefaultLang holds the default language for validation error messages.
 	DefaultLang string
+
+	registry     *validator.Registry // registry holds the validation rules of the validator
+	registryOnce sync.Once           // registryOnce initializes the registry of a zero-value Validator
 }
 
 // NewValidator creates a new instance of Validator with the default language set to English.
@@ -17,30 +81,170 @@ func NewValidator() *Validator {
 func NewValidatorWithLang(defaultLang string) *Validator {
 	return &Validator{
 		DefaultLang: defaultLang,
+		registry:    validator.NewRegistry(),
 	}
 }
 
 // Validate performs validation on the input struct using the default language.
-// It registers default validation rules and then validates the struct fields.
 func (v *Validator) Validate(input interface{}) error {
 	return v.ValidateWithLang(input, v.DefaultLang)
 }
 
 // ValidateWithLang performs validation on the input struct using the specified language.
 // It validates the struct fields based on the validation tags and returns any validation errors encountered.
+// Validation failures are returned as ValidationErrors.
+//
+// The language is a BCP 47 tag such as "tr", "en-GB" or "pt_BR". Messages are looked up along its fallback chain
+// (e.g. "pt-BR", then "pt"), then in the default language and finally in English, falling back per message key.
 func (v *Validator) ValidateWithLang(input interface{}, lang string) error {
-	validator.RegisterDefaultValidationRules()
-	return validator.ValidateStruct(input, lang)
+	return v.rules().Validate(input, validator.Options{Lang: lang, DefaultLang: v.DefaultLang})
+}
+
+// ValidateCtx performs validation on the input struct using the default language, passing the context to
+// context rules registered with RegisterContextRule. Rules registered without a context keep working unchanged.
+// Fields are no longer validated once the context is cancelled, and the context error is returned instead.
+func (v *Validator) ValidateCtx(ctx context.Context, input interface{}) error {
+	return v.ValidateCtxWithLang(ctx, input, v.DefaultLang)
+}
+
+// ValidateCtxWithLang performs validation on the input struct like ValidateCtx, using the specified language.
+func (v *Validator) ValidateCtxWithLang(ctx context.Context, input interface{}, lang string) error {
+	return v.rules().ValidateCtx(ctx, input, validator.Options{Lang: lang, DefaultLang: v.DefaultLang})
+}
+
+// ValidateGroups performs validation on the input struct using the default language, applying the rules
+// of the given validation groups in addition to the ungrouped rules. Rules are assigned to a group with
+// the "@" suffix, e.g. `validate:"required@create,min=8"` only requires the field when validating the "create" group.
+func (v *Validator) ValidateGroups(input interface{}, groups ...string) error {
+	return v.rules().Validate(input, validator.Options{Lang: v.DefaultLang, DefaultLang: v.DefaultLang, Groups: groups})
+}
+
+// ValidateFields performs validation on the input struct using the default language, validating only the fields
+// with the given dotted paths, such as "Email" or "Address.City". Selecting a struct field validates all its nested fields,
+// and collection indices are ignored, so "Items.SKU" validates the SKU of every item.
+// It is meant for PATCH handlers that validate only the fields sent by the client.
+// It returns an error if a path doesn't name a field of the struct, so that a typo doesn't skip validation.
+func (v *Validator) ValidateFields(input interface{}, fields ...string) error {
+	return v.rules().Validate(input, validator.Options{Lang: v.DefaultLang, DefaultLang: v.DefaultLang, Fields: fields})
+}
+
+// ValidateExcept performs validation on the input struct using the default language, skipping the fields
+// with the given dotted paths and the fields nested in them. It returns an error if a path doesn't name a field of the struct.
+func (v *Validator) ValidateExcept(input interface{}, fields ...string) error {
+	return v.rules().Validate(input, validator.Options{Lang: v.DefaultLang, DefaultLang: v.DefaultLang, Except: fields})
+}
+
+// Var validates a single value, such as a query parameter or a command-line flag, against a validation tag
+// such as "required,email", using the default language. Error messages refer to the value as "Value".
+func (v *Validator) Var(value interface{}, tag string) error {
+	return v.VarField("", value, tag, v.DefaultLang)
+}
+
+// VarField validates a single value against a validation tag like Var, using the specified language.
+// The name is the field name used in error messages and paths, and the tag may hold language aliases for it,
+// e.g. v.VarField("Email", email, "required,email,tr=E-posta", "tr").
+func (v *Validator) VarField(name string, value interface{}, tag, lang string) error {
+	return v.rules().ValidateVar(name, value, tag, validator.Options{Lang: lang, DefaultLang: v.DefaultLang})
+}
+
+// ValidateMap validates dynamic data, such as JSON decoded into a map[string]interface{}, using the default language.
+// The keys of the rule map are dotted paths into the data, where "*" matches every element of a slice or map,
+// e.g. map[string]string{"user.email": "required,email", "items.*.sku": "required"}.
+// Missing keys are validated like empty strings.
+func (v *Validator) ValidateMap(data map[string]interface{}, rules map[string]string) error {
+	return v.ValidateMapWithLang(data, rules, v.DefaultLang)
+}
+
+// ValidateMapWithLang validates dynamic data against a rule map like ValidateMap, using the specified language.
+func (v *Validator) ValidateMapWithLang(data map[string]interface{}, rules map[string]string, lang string) error {
+	return v.rules().ValidateMap(data, rules, validator.Options{Lang: lang, DefaultLang: v.DefaultLang})
 }
 
 // SetLang sets the default language for validation error messages.
+// It is not safe to call SetLang while other goroutines are validating with the same validator.
 func (v *Validator) SetLang(lang string) {
 	v.DefaultLang = lang
 }
 
 // RegisterValidationRule registers a custom validation rule with a given name and validation function.
-func (v *Validator) RegisterValidationRule(name string, validateFunc validator.ValidationRule) {
-	validator.RegisterValidationRule(name, validateFunc)
+// Registering a rule with the name of a default rule, such as "min" or "email", overrides it for this validator only.
+func (v *Validator) RegisterValidationRule(name string, validateFunc ValidationRule) {
+	v.rules().RegisterValidationRule(name, validateFunc)
+}
+
+// RegisterFieldRule registers a custom validation rule that receives a RuleContext, giving access to the struct
+// containing the field and to the top-level struct. It overrides any existing rule with the same name for this validator.
+func (v *Validator) RegisterFieldRule(name string, validateFunc FieldRule) {
+	v.rules().RegisterFieldRule(name, validateFunc)
+}
+
+// RegisterContextRule registers a custom validation rule that receives the context passed to ValidateCtx,
+// or context.Background() when validating without a context. It overrides any existing rule with the same name for this validator.
+func (v *Validator) RegisterContextRule(name string, validateFunc ContextRule) {
+	v.rules().RegisterContextRule(name, validateFunc)
+}
+
+// RegisterStructRule registers a struct-level validation rule for the type of the given struct value
+// for this validator, e.g. RegisterStructRule(Order{}, validateOrder), for types that cannot implement Validatable.
+// It returns an error if the value is not a struct or a pointer to a struct.
+func (v *Validator) RegisterStructRule(structValue interface{}, validateFunc FieldRule) error {
+	return v.rules().RegisterStructRule(structValue, validateFunc)
+}
+
+// RegisterPattern compiles a regular expression and registers it under a name for this validator,
+// to be used as "pattern=name", e.g. RegisterPattern("sku", `^[A-Z]{3}-\d{4}$`) for `validate:"pattern=sku"`.
+// It returns an error if the regular expression cannot be compiled.
+func (v *Validator) RegisterPattern(name, pattern string) error {
+	return v.rules().RegisterPattern(name, pattern)
+}
+
+// RemoveValidationRule removes the validation rule with the given name from this validator.
+// Tags referring to a removed rule are skipped during validation.
+func (v *Validator) RemoveValidationRule(name string) {
+	v.rules().RemoveValidationRule(name)
+}
+
+// RegisterLocale registers error messages for a language, e.g. to add a language that is not bundled
+// or to replace the wording of bundled messages. The messages are merged into the messages already
+// registered for the language, and keys that are not registered keep their bundled messages.
+func (v *Validator) RegisterLocale(lang string, messages map[string]string) {
+	v.rules().RegisterLocale(lang, messages)
+}
+
+// RegisterLocaleReader reads error messages for a language as a JSON object from a reader and registers them.
+func (v *Validator) RegisterLocaleReader(lang string, r io.Reader) error {
+	return v.rules().RegisterLocaleReader(lang, r)
+}
+
+// RegisterLocaleFS registers the error messages of every JSON file in the root directory of a file system,
+// taking the language from the file name (e.g. "de.json" for German).
+// Use fs.Sub to register the files of a subdirectory.
+func (v *Validator) RegisterLocaleFS(fsys fs.FS) error {
+	return v.rules().RegisterLocaleFS(fsys)
+}
+
+// SetMessage overrides a single error message of a language, e.g. the "required" message for "en".
+func (v *Validator) SetMessage(lang, key, message string) {
+	v.rules().SetMessage(lang, key, message)
+}
+
+// MatchLanguage picks the best language with bundled or registered messages for an HTTP Accept-Language header,
+// such as "pt-BR,pt;q=0.9,en;q=0.8". It returns the default language if none of the requested languages is available.
+func (v *Validator) MatchLanguage(acceptLanguage string) string {
+	if lang, ok := v.rules().MatchAcceptLanguage(acceptLanguage); ok {
+		return lang
+	}
+	return v.DefaultLang
+}
+
+// rules returns the rule registry of the validator, creating it if the Validator was not created with NewValidator.
+func (v *Validator) rules() *validator.Registry {
+	v.registryOnce.Do(func() {
+		if v.registry == nil {
+			v.registry = validator.NewRegistry()
+		}
+	})
+	return v.registry
 }
 
 // Example usage:
@@ -1,9 +1,11 @@
 package validator
 
 import (
+	"context"
 	"errors"
 	"github.com/abdullahkabakk/validator/internal/validator/locales"
 	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -126,3 +128,521 @@ func TestRegisterValidationRule(t *testing.T) {
 		t.Errorf("Expected validator to pass, got error: %v", err)
 	}
 }
+
+// TestValidateReturnsValidationErrors tests that Validate returns structured validation errors.
+func TestValidateReturnsValidationErrors(t *testing.T) {
+	// Define a struct for testing
+	type User struct {
+		Username string `validate:"required"`
+		Email    string `validate:"required,email"`
+	}
+
+	// Create a validator instance
+	v := NewValidator()
+
+	// Invalid user (missing username, invalid email)
+	err := v.Validate(User{Email: "invalid"})
+
+	// Retrieve the validation errors
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected error of type ValidationErrors, got %T", err)
+	}
+
+	// Check the failing fields and rules
+	expected := []struct{ field, rule string }{
+		{"Username", "required"},
+		{"Email", "email"},
+	}
+	if len(validationErrors) != len(expected) {
+		t.Fatalf("Expected %d field errors, got %d", len(expected), len(validationErrors))
+	}
+	for i, e := range expected {
+		if validationErrors[i].Field != e.field || validationErrors[i].Rule != e.rule {
+			t.Errorf("Expected field error %s/%s, got %s/%s", e.field, e.rule, validationErrors[i].Field, validationErrors[i].Rule)
+		}
+	}
+}
+
+// TestValidatorRuleIsolation tests that each validator owns its validation rules.
+func TestValidatorRuleIsolation(t *testing.T) {
+	type Data struct {
+		Value string `validate:"min=3"`
+	}
+
+	public := NewValidator()
+	admin := NewValidator()
+
+	// Remove the "min" rule from the admin validator only
+	admin.RemoveValidationRule("min")
+
+	if err := public.Validate(Data{Value: "ab"}); err == nil {
+		t.Errorf("Expected public validator to fail, but it passed")
+	}
+	if err := admin.Validate(Data{Value: "ab"}); err != nil {
+		t.Errorf("Expected admin validator to pass, got error: %v", err)
+	}
+}
+
+// TestZeroValueValidator tests that a Validator that was not created with NewValidator uses the default rules.
+func TestZeroValueValidator(t *testing.T) {
+	type Data struct {
+		Value string `validate:"required"`
+	}
+
+	v := &Validator{DefaultLang: "en"}
+	if err := v.Validate(Data{}); err == nil {
+		t.Errorf("Expected validator to fail, but it passed")
+	}
+}
+
+// TestValidatorConcurrentUse tests validating from many goroutines while rules are being registered.
+// Run with -race to detect data races.
+func TestValidatorConcurrentUse(t *testing.T) {
+	type User struct {
+		Username string `validate:"required,min=3,custom"`
+		Email    string `validate:"required,email"`
+	}
+
+	v := NewValidator()
+	passing := func(value reflect.Value, messages locales.ErrorMessages, fieldValue string, rule string) error {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		// Register the custom rule concurrently
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				v.RegisterValidationRule("custom", passing)
+			}
+		}()
+
+		// Validate concurrently, in English and Turkish
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			lang := "en"
+			if i%2 == 0 {
+				lang = "tr"
+			}
+			for j := 0; j < 100; j++ {
+				if err := v.ValidateWithLang(User{}, lang); err == nil {
+					t.Error("Expected validator to fail, but it passed")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// TestRegisterLocale tests registering a custom locale and overriding a single message.
+func TestRegisterLocale(t *testing.T) {
+	type User struct {
+		Username string `validate:"required"`
+	}
+
+	v := NewValidator()
+	v.RegisterLocale("de", map[string]string{"required": "%s ist erforderlich"})
+	v.SetMessage("en", "required", "Please enter your %s")
+
+	if err := v.ValidateWithLang(User{}, "de"); err == nil || err.Error() != "Username ist erforderlich" {
+		t.Errorf("Expected German message, got '%v'", err)
+	}
+	if err := v.ValidateWithLang(User{}, "en"); err == nil || err.Error() != "Please enter your Username" {
+		t.Errorf("Expected overridden English message, got '%v'", err)
+	}
+}
+
+// TestMatchLanguage tests picking the best language for an Accept-Language header.
+func TestMatchLanguage(t *testing.T) {
+	v := NewValidatorWithLang("tr")
+
+	if lang := v.MatchLanguage("en-GB,en;q=0.9"); lang != "en" {
+		t.Errorf("Expected 'en', got '%s'", lang)
+	}
+	if lang := v.MatchLanguage("de,fr;q=0.5"); lang != "tr" {
+		t.Errorf("Expected default language 'tr', got '%s'", lang)
+	}
+}
+
+// TestValidateWithLangFallback tests falling back to the default language for an unknown language.
+func TestValidateWithLangFallback(t *testing.T) {
+	type User struct {
+		Username string `validate:"required"`
+	}
+
+	v := NewValidatorWithLang("tr")
+	if err := v.ValidateWithLang(User{}, "de-DE"); err == nil || err.Error() != "Username zorunludur" {
+		t.Errorf("Expected Turkish message, got '%v'", err)
+	}
+	if err := v.ValidateWithLang(User{}, "en_GB"); err == nil || err.Error() != "Username is required" {
+		t.Errorf("Expected English message, got '%v'", err)
+	}
+}
+
+// TestNewRuleError tests reporting rule parameters from a custom rule with a named message template.
+func TestNewRuleError(t *testing.T) {
+	type Order struct {
+		Currency string `validate:"currency"`
+	}
+
+	v := NewValidator()
+	v.SetMessage("en", "currency", "{field} must be one of {values}")
+	v.RegisterValidationRule("currency", func(value reflect.Value, messages ErrorMessages, fieldName string, rule string) error {
+		if value.String() != "EUR" && value.String() != "USD" {
+			return NewRuleError(messages, "currency", fieldName, MessageParam{Name: "values", Value: "EUR, USD"})
+		}
+		return nil
+	})
+
+	err := v.Validate(Order{Currency: "TRY"})
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected error of type ValidationErrors, got %T", err)
+	}
+	if validationErrors[0].Message != "Currency must be one of EUR, USD" {
+		t.Errorf("Unexpected message '%s'", validationErrors[0].Message)
+	}
+	if validationErrors[0].Params["values"] != "EUR, USD" {
+		t.Errorf("Unexpected params %v", validationErrors[0].Params)
+	}
+}
+
+// TestCrossFieldValidation tests cross-field comparison rules and custom field rules.
+func TestCrossFieldValidation(t *testing.T) {
+	type Signup struct {
+		Password        string `validate:"required,min=8"`
+		PasswordConfirm string `validate:"eqfield=Password"`
+		StartDate       string `validate:"date"`
+		EndDate         string `validate:"date,gtfield=StartDate,notstart"`
+	}
+
+	v := NewValidator()
+	v.RegisterFieldRule("notstart", func(rc *RuleContext) error {
+		if rc.Value.String() == rc.Parent.FieldByName("StartDate").String() {
+			return errors.New("EndDate must differ from StartDate")
+		}
+		return nil
+	})
+
+	valid := Signup{Password: "12345678", PasswordConfirm: "12345678", StartDate: "2024-01-01", EndDate: "2024-01-02"}
+	if err := v.Validate(valid); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+
+	invalid := Signup{Password: "12345678", PasswordConfirm: "1234567", StartDate: "2024-01-01", EndDate: "2024-01-01"}
+	err := v.Validate(invalid)
+	expected := "PasswordConfirm must be equal to Password;\nEndDate must be greater than StartDate;\nEndDate must differ from StartDate"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestRegisterStructRule tests struct-level rules registered on a validator.
+func TestRegisterStructRule(t *testing.T) {
+	type Invoice struct {
+		Lines []int `validate:"required"`
+		Total int
+	}
+
+	v := NewValidator()
+	err := v.RegisterStructRule(Invoice{}, func(rc *RuleContext) error {
+		invoice := rc.Value.Interface().(Invoice)
+		sum := 0
+		for _, line := range invoice.Lines {
+			sum += line
+		}
+		if sum != invoice.Total {
+			return FieldError{Field: "Total", Rule: "sum", Message: "Total must equal the sum of the lines"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got '%v'", err)
+	}
+
+	if err := v.Validate(Invoice{Lines: []int{1, 2}, Total: 3}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+
+	err = v.Validate(Invoice{Total: 3})
+	expected := "Lines is required;\nTotal must equal the sum of the lines"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestValidateCtx tests passing a context to context rules and stopping on cancellation.
+func TestValidateCtx(t *testing.T) {
+	type User struct {
+		Email string `validate:"required,email,available"`
+	}
+
+	v := NewValidator()
+	v.RegisterContextRule("available", func(ctx context.Context, rc *RuleContext) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if rc.Value.String() == "taken@example.com" {
+			return errors.New("Email is already registered")
+		}
+		return nil
+	})
+
+	if err := v.ValidateCtx(context.Background(), User{Email: "john@example.com"}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+	err := v.ValidateCtx(context.Background(), User{Email: "taken@example.com"})
+	if err == nil || err.Error() != "Email is already registered" {
+		t.Errorf("Expected error 'Email is already registered', got '%v'", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := v.ValidateCtx(ctx, User{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got '%v'", err)
+	}
+}
+
+// TestValidateGroups tests applying the rules of the selected validation groups.
+func TestValidateGroups(t *testing.T) {
+	type User struct {
+		Name     string `validate:"required"`
+		Password string `validate:"required@create,omitempty,min=8"`
+	}
+
+	v := NewValidator()
+
+	// Grouped rules are skipped without groups
+	if err := v.Validate(User{Name: "John"}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+	if err := v.ValidateGroups(User{Name: "John"}, "update"); err != nil {
+		t.Errorf("Expected validator to pass for update, got error: %v", err)
+	}
+
+	err := v.ValidateGroups(User{}, "create")
+	expected := "Name is required;\nPassword is required"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestValidateFields tests validating only selected fields and skipping excepted fields.
+func TestValidateFields(t *testing.T) {
+	type Address struct {
+		City    string `validate:"required"`
+		ZipCode string `validate:"required"`
+	}
+	type Profile struct {
+		Name    string `validate:"required"`
+		Email   string `validate:"email"`
+		Address Address
+	}
+
+	v := NewValidator()
+	input := Profile{Email: "invalid"}
+
+	err := v.ValidateFields(input, "Email", "Address.City")
+	expected := "Email is not a valid email address;\nCity is required"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+
+	err = v.ValidateExcept(input, "Email", "Address")
+	if err == nil || err.Error() != "Name is required" {
+		t.Errorf("Expected error 'Name is required', got '%v'", err)
+	}
+}
+
+// TestVar tests validating single values.
+func TestVar(t *testing.T) {
+	v := NewValidator()
+
+	if err := v.Var("john@example.com", "required,email"); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+	if err := v.Var("", "required"); err == nil || err.Error() != "Value is required" {
+		t.Errorf("Expected error 'Value is required', got '%v'", err)
+	}
+
+	err := v.VarField("Password", "abc", "min=8,tr=Şifre", "tr")
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 1 {
+		t.Fatalf("Expected one validation error, got '%v'", err)
+	}
+	if fieldErr := validationErrors[0]; fieldErr.Field != "Password" || fieldErr.Rule != "min" || fieldErr.Message != "Şifre en az 8 karakter olmalıdır" {
+		t.Errorf("Unexpected field error %+v", fieldErr)
+	}
+}
+
+// TestValidateMap tests validating dynamic data against a rule map.
+func TestValidateMap(t *testing.T) {
+	v := NewValidator()
+
+	data := map[string]interface{}{
+		"email": "john@example.com",
+		"items": []interface{}{map[string]interface{}{"sku": "A-1"}, map[string]interface{}{}},
+	}
+	rules := map[string]string{
+		"email":       "required,email",
+		"items.*.sku": "required",
+		"name":        "required",
+	}
+
+	err := v.ValidateMap(data, rules)
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 2 {
+		t.Fatalf("Expected two validation errors, got '%v'", err)
+	}
+	if validationErrors[0].Path != "items[1].sku" || validationErrors[1].Path != "name" {
+		t.Errorf("Unexpected validation errors %+v", validationErrors)
+	}
+}
+
+// TestTagSyntax tests quoted rule parameters and malformed tags.
+func TestTagSyntax(t *testing.T) {
+	type Trip struct {
+		From string
+		To   string `validate:"required_if=From 'New York'"`
+	}
+
+	v := NewValidator()
+	err := v.Validate(Trip{From: "New York"})
+	if err == nil || err.Error() != "To is required" {
+		t.Errorf("Expected error 'To is required', got '%v'", err)
+	}
+
+	var tagErr *TagError
+	if err := v.Var("x", "required,,min=1"); !errors.As(err, &tagErr) || tagErr.Reason != "empty rule" {
+		t.Errorf("Expected TagError for an empty rule, got '%v'", err)
+	}
+}
+
+// TestCompositeRules tests alternations and negated rules.
+func TestCompositeRules(t *testing.T) {
+	type Contact struct {
+		Reach string `validate:"email|e164"`
+		Code  string `validate:"!special"`
+	}
+
+	v := NewValidator()
+	if err := v.Validate(Contact{Reach: "+14155552671", Code: "abc"}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+
+	err := v.Validate(Contact{Reach: "not reachable", Code: "a#c"})
+	expected := "Reach must satisfy one of the following: Reach is not a valid email address or Reach must be a valid E.164 phone number;\n" +
+		"Code must not satisfy the special rule"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestNumericRules tests numeric comparison rules.
+func TestNumericRules(t *testing.T) {
+	type Product struct {
+		Price    float64 `validate:"gt=0,lte=99.99"`
+		Quantity uint    `validate:"between=1 10"`
+	}
+
+	v := NewValidator()
+	if err := v.Validate(Product{Price: 99.99, Quantity: 10}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+
+	err := v.Validate(Product{Price: 123.45, Quantity: 0})
+	expected := "Price must be less than or equal to 99.99;\nQuantity must be between 1 and 10"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// TestLengthRules tests Unicode-aware length rules and item counts.
+func TestLengthRules(t *testing.T) {
+	type Profile struct {
+		Name   string   `validate:"max=5"`
+		Status string   `validate:"max=2 graphemes"`
+		Code   string   `validate:"len=3"`
+		Tags   []string `validate:"min=1,max=3"`
+	}
+
+	v := NewValidatorWithLang("tr")
+	if err := v.Validate(Profile{Name: "Çağrı", Status: "👍🏽🎉", Code: "ÇĞŞ", Tags: []string{"go"}}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+
+	err := v.Validate(Profile{Name: "Çağrıcan", Status: "👍🏽🎉✨", Code: "AB", Tags: []string{"a", "b", "c", "d"}})
+	expected := "Name en fazla 5 karakter olabilir;\nStatus en fazla 2 karakter olabilir;\n" +
+		"Code tam olarak 3 karakter olmalıdır;\nTags en fazla 3 öğe içerebilir"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+// Plan is an enumeration of subscription plans listing its values.
+type Plan string
+
+// Values returns the available plans.
+func (Plan) Values() []Plan {
+	return []Plan{"free", "pro"}
+}
+
+func TestEnumRules(t *testing.T) {
+	type Account struct {
+		Plan     Plan   `validate:"oneof"`
+		City     string `validate:"oneof='New York' Paris"`
+		Username string `validate:"noneofci=admin root"`
+	}
+
+	v := NewValidator()
+	if err := v.Validate(Account{Plan: "pro", City: "New York", Username: "alice"}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+
+	err := v.Validate(Account{Plan: "team", City: "Berlin", Username: "Admin"})
+	expected := "Plan must be one of: free, pro;\nCity must be one of: New York, Paris;\n" +
+		"Username must not be one of: admin, root"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+func TestPatternRules(t *testing.T) {
+	type Product struct {
+		SKU  string `validate:"pattern=sku"`
+		Code string `validate:"regexp='^[a-z]{2,4}$'"`
+	}
+
+	v := NewValidatorWithLang("tr")
+	if err := v.RegisterPattern("sku", `^[A-Z]{3}-\d{4}$`); err != nil {
+		t.Fatalf("Expected no error, got '%v'", err)
+	}
+	if err := v.RegisterPattern("broken", "[a-"); err == nil {
+		t.Error("Expected error for invalid pattern, got none")
+	}
+
+	if err := v.Validate(Product{SKU: "ABC-1234", Code: "ab"}); err != nil {
+		t.Errorf("Expected validator to pass, got error: %v", err)
+	}
+
+	err := v.Validate(Product{SKU: "abc", Code: "abcde"})
+	expected := "SKU geçersiz biçimde;\nCode geçersiz biçimde"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got '%v'", expected, err)
+	}
+}
+
+func TestValidateFieldsUnknownField(t *testing.T) {
+	type Patch struct {
+		Email string `validate:"required,email"`
+	}
+
+	err := NewValidator().ValidateFields(Patch{}, "Emial")
+	if err == nil || err.Error() != `unknown field "Emial"` {
+		t.Errorf("Expected error 'unknown field \"Emial\"', got '%v'", err)
+	}
+}